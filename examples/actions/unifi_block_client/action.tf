@@ -0,0 +1,13 @@
+action "unifi_block_client" "laptop" {
+  config {
+    mac = "11:22:33:44:55:66"
+  }
+}
+
+# Unblock
+action "unifi_block_client" "laptop_unblock" {
+  config {
+    mac     = "11:22:33:44:55:66"
+    blocked = false
+  }
+}
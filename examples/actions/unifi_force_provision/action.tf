@@ -0,0 +1,18 @@
+resource "unifi_wlan" "office" {
+  name       = "Office"
+  security   = "wpapsk"
+  passphrase = "securepassword"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.unifi_force_provision.office_ap]
+    }
+  }
+}
+
+action "unifi_force_provision" "office_ap" {
+  config {
+    mac = "aa:bb:cc:dd:ee:ff"
+  }
+}
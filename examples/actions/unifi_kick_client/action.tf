@@ -0,0 +1,5 @@
+action "unifi_kick_client" "laptop" {
+  config {
+    mac = "11:22:33:44:55:66"
+  }
+}
@@ -0,0 +1,13 @@
+action "unifi_locate" "office_ap" {
+  config {
+    mac = "aa:bb:cc:dd:ee:ff"
+  }
+}
+
+# Stop blinking
+action "unifi_locate" "office_ap_off" {
+  config {
+    mac     = "aa:bb:cc:dd:ee:ff"
+    enabled = false
+  }
+}
@@ -0,0 +1,6 @@
+action "unifi_power_cycle_port" "camera" {
+  config {
+    mac      = "aa:bb:cc:dd:ee:01"
+    port_idx = 8
+  }
+}
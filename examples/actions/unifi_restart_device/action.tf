@@ -0,0 +1,5 @@
+action "unifi_restart_device" "office_ap" {
+  config {
+    mac = "aa:bb:cc:dd:ee:ff"
+  }
+}
@@ -0,0 +1,5 @@
+action "unifi_upgrade_firmware" "office_ap" {
+  config {
+    mac = "aa:bb:cc:dd:ee:ff"
+  }
+}
@@ -0,0 +1,10 @@
+ephemeral "unifi_wlan_passphrase" "guest" {
+  length = 20
+}
+
+resource "unifi_wlan" "guest" {
+  name                  = "Guest"
+  security              = "wpapsk"
+  passphrase_wo         = ephemeral.unifi_wlan_passphrase.guest.passphrase
+  passphrase_wo_version = 1
+}
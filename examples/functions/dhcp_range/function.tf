@@ -0,0 +1,11 @@
+locals {
+  dhcp = provider::unifi::dhcp_range("10.0.20.0/24", 100, -2)
+}
+
+output "dhcp_start" {
+  value = local.dhcp.start # "10.0.20.100"
+}
+
+output "dhcp_stop" {
+  value = local.dhcp.stop # "10.0.20.254"
+}
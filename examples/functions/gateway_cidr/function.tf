@@ -0,0 +1,5 @@
+resource "unifi_network" "iot" {
+  name    = "IoT"
+  vlan_id = 20
+  subnet  = provider::unifi::gateway_cidr("10.0.20.0/24") # "10.0.20.1/24"
+}
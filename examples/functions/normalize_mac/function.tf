@@ -0,0 +1,4 @@
+resource "unifi_user" "printer" {
+  mac  = provider::unifi::normalize_mac("AA-BB-CC-DD-EE-FF") # "aa:bb:cc:dd:ee:ff"
+  name = "Printer"
+}
@@ -0,0 +1,11 @@
+variable "vlans" {
+  default = { iot = 20, cameras = 30 }
+}
+
+resource "unifi_network" "vlan" {
+  for_each = var.vlans
+
+  name    = each.key
+  vlan_id = each.value
+  subnet  = provider::unifi::gateway_cidr(provider::unifi::vlan_subnet("10.0.0.0/16", each.value))
+}
@@ -0,0 +1,4 @@
+output "guest_wifi_qr" {
+  value     = provider::unifi::wifi_qr("Guest", "wpapsk", var.guest_passphrase)
+  sensitive = true
+}
@@ -0,0 +1,4 @@
+list "unifi_admin" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "unifi_ap_group" "all" {
+  provider         = unifi
+  include_resource = true
+}
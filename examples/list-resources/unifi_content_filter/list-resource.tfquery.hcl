@@ -0,0 +1,4 @@
+list "unifi_content_filter" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "unifi_firewall_group" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "unifi_firewall_rule" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "unifi_guest_portal" "all" {
+  provider         = unifi
+  include_resource = true
+}
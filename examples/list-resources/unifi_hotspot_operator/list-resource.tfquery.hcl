@@ -0,0 +1,4 @@
+list "unifi_hotspot_operator" "all" {
+  provider         = unifi
+  include_resource = true
+}
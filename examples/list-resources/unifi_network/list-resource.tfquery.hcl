@@ -0,0 +1,4 @@
+list "unifi_network" "all" {
+  provider         = unifi
+  include_resource = true
+}
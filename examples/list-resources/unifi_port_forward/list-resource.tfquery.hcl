@@ -0,0 +1,4 @@
+list "unifi_port_forward" "all" {
+  provider         = unifi
+  include_resource = true
+}
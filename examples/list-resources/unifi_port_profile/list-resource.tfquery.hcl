@@ -0,0 +1,4 @@
+list "unifi_port_profile" "all" {
+  provider         = unifi
+  include_resource = true
+}
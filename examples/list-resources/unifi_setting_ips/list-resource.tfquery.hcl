@@ -0,0 +1,4 @@
+list "unifi_setting_ips" "all" {
+  provider         = unifi
+  include_resource = true
+}
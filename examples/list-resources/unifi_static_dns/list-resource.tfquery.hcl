@@ -0,0 +1,4 @@
+list "unifi_static_dns" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "unifi_static_route" "all" {
+  provider         = unifi
+  include_resource = true
+}
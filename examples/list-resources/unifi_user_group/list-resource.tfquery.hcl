@@ -0,0 +1,4 @@
+list "unifi_user_group" "all" {
+  provider         = unifi
+  include_resource = true
+}
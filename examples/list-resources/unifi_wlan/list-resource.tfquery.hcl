@@ -0,0 +1,4 @@
+list "unifi_wlan" "all" {
+  provider         = unifi
+  include_resource = true
+}
@@ -0,0 +1,7 @@
+resource "unifi_admin" "noc" {
+  name                  = "NOC Engineer"
+  email                 = "noc@example.com"
+  role                  = "admin"
+  permissions           = ["API_DEVICE_RESTART", "API_CLIENT_BLOCK"]
+  allow_device_adoption = true
+}
@@ -0,0 +1,8 @@
+resource "unifi_content_filter" "kids" {
+  name        = "Kids"
+  network_ids = [unifi_network.kids.id]
+  categories  = ["ADULT", "GAMBLING", "DRUGS"]
+  safe_search = ["GOOGLE", "YOUTUBE", "BING"]
+  allow_list  = ["khanacademy.org"]
+  block_list  = ["example-game.com"]
+}
@@ -0,0 +1,10 @@
+resource "unifi_guest_portal" "lobby" {
+  auth             = "hotspot"
+  portal_enabled   = true
+  title            = "Welcome to the Lobby"
+  terms_of_service = "Be nice. No illegal activity."
+  expire           = 480
+  redirect_url     = "https://example.com/welcome"
+  voucher_enabled  = true
+  allowed_subnets  = ["192.168.1.10/32"]
+}
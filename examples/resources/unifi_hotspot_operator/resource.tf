@@ -0,0 +1,5 @@
+resource "unifi_hotspot_operator" "front_desk" {
+  name     = "frontdesk"
+  password = var.front_desk_password
+  note     = "Lobby reception staff"
+}
@@ -0,0 +1,13 @@
+resource "unifi_hotspot_voucher" "front_desk" {
+  quantity        = 20
+  duration        = 1440
+  quota           = 1
+  down_limit_kbps = 20000
+  up_limit_kbps   = 5000
+  note            = "Front desk day passes"
+}
+
+output "voucher_codes" {
+  value     = unifi_hotspot_voucher.front_desk.codes
+  sensitive = true
+}
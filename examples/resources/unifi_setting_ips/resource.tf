@@ -0,0 +1,24 @@
+resource "unifi_setting_ips" "site" {
+  ips_mode            = "ips"
+  enabled_categories  = ["emerging-malware", "emerging-scan", "emerging-exploit", "tor"]
+  enabled_network_ids = [unifi_network.home_vlan.id]
+  dpi_enabled         = true
+
+  ad_blocking_enabled     = true
+  ad_blocking_network_ids = [unifi_network.home_vlan.id]
+
+  allowlist = [
+    {
+      direction = "src"
+      mode      = "ip"
+      value     = "192.168.10.5"
+    }
+  ]
+
+  suppressed_alerts = [
+    {
+      signature_id = 2010935
+      category     = "emerging-scan"
+    }
+  ]
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// listCacheTTL bounds how long a list response is reused. It only needs to
+// span the burst of reads Terraform issues during a single plan or apply.
+const listCacheTTL = 30 * time.Second
+
+// listCache holds recent list responses keyed by request path, which includes
+// the site. Concurrent misses for the same path share one request, and any
+// write through the client (doWrite) clears the cache.
+type listCache struct {
+	mu      sync.Mutex
+	entries map[string]*listCacheEntry
+}
+
+type listCacheEntry struct {
+	ready   chan struct{}
+	data    json.RawMessage
+	err     error
+	expires time.Time
+}
+
+func (lc *listCache) get(ctx context.Context, key string, fetch func() (json.RawMessage, error)) (json.RawMessage, error) {
+	lc.mu.Lock()
+	if lc.entries == nil {
+		lc.entries = map[string]*listCacheEntry{}
+	}
+	if e, ok := lc.entries[key]; ok {
+		select {
+		case <-e.ready:
+			if time.Now().Before(e.expires) {
+				lc.mu.Unlock()
+				tflog.SubsystemTrace(withLogSubsystem(ctx), logSubsystem, "Using cached UniFi API response", map[string]any{
+					"path": key,
+				})
+				return e.data, nil
+			}
+		default:
+			lc.mu.Unlock()
+			select {
+			case <-e.ready:
+				return e.data, e.err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+	e := &listCacheEntry{ready: make(chan struct{})}
+	lc.entries[key] = e
+	lc.mu.Unlock()
+
+	e.data, e.err = fetch()
+	e.expires = time.Now().Add(listCacheTTL)
+	close(e.ready)
+
+	if e.err != nil {
+		lc.mu.Lock()
+		if lc.entries[key] == e {
+			delete(lc.entries, key)
+		}
+		lc.mu.Unlock()
+	}
+	return e.data, e.err
+}
+
+func (lc *listCache) invalidate() {
+	lc.mu.Lock()
+	lc.entries = nil
+	lc.mu.Unlock()
+}
+
+// cachedList GETs the list at path, reusing a recent response when possible.
+// Each caller decodes its own copy, so results may be modified freely.
+func cachedList[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	raw, err := c.cache.get(ctx, path, func() (json.RawMessage, error) {
+		var raw json.RawMessage
+		err := c.doRequest(ctx, "GET", path, nil, &raw)
+		return raw, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
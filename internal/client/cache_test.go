@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListCacheReuse(t *testing.T) {
+	var lc listCache
+	var fetches int
+	fetch := func() (json.RawMessage, error) {
+		fetches++
+		return json.RawMessage(`[1]`), nil
+	}
+
+	for range 3 {
+		if _, err := lc.get(context.Background(), "/a", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("got %d fetches, want 1", fetches)
+	}
+
+	if _, err := lc.get(context.Background(), "/b", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fetches != 2 {
+		t.Errorf("got %d fetches after a different path, want 2", fetches)
+	}
+}
+
+func TestListCacheExpiry(t *testing.T) {
+	var lc listCache
+	var fetches int
+	fetch := func() (json.RawMessage, error) {
+		fetches++
+		return json.RawMessage(`[]`), nil
+	}
+
+	if _, err := lc.get(context.Background(), "/a", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if e := lc.entries["/a"]; e.expires.Sub(time.Now()) > listCacheTTL {
+		t.Errorf("entry expires in %s, want at most %s", time.Until(e.expires), listCacheTTL)
+	}
+
+	lc.entries["/a"].expires = time.Now().Add(-time.Second)
+	if _, err := lc.get(context.Background(), "/a", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fetches != 2 {
+		t.Errorf("got %d fetches, want the expired entry fetched again", fetches)
+	}
+}
+
+func TestListCacheErrorsNotCached(t *testing.T) {
+	var lc listCache
+	var fetches int
+	fetch := func() (json.RawMessage, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return json.RawMessage(`[]`), nil
+	}
+
+	if _, err := lc.get(context.Background(), "/a", fetch); err == nil {
+		t.Fatal("expected the fetch error")
+	}
+	if _, err := lc.get(context.Background(), "/a", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fetches != 2 {
+		t.Errorf("got %d fetches, want the failed fetch retried", fetches)
+	}
+}
+
+func TestListCacheSingleFlight(t *testing.T) {
+	var lc listCache
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (json.RawMessage, error) {
+		fetches.Add(1)
+		<-release
+		return json.RawMessage(`[1,2]`), nil
+	}
+
+	const callers = 8
+	var wg sync.WaitGroup
+	results := make([]string, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			raw, err := lc.get(context.Background(), "/a", fetch)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			results[i] = string(raw)
+		}()
+	}
+	// Let the callers queue up behind the first fetch before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("got %d fetches for concurrent callers, want 1", n)
+	}
+	for i, r := range results {
+		if r != `[1,2]` {
+			t.Errorf("caller %d got %q", i, r)
+		}
+	}
+}
+
+func TestListCacheWaitHonoursContext(t *testing.T) {
+	var lc listCache
+	release := make(chan struct{})
+	defer close(release)
+	go lc.get(context.Background(), "/a", func() (json.RawMessage, error) {
+		<-release
+		return nil, nil
+	})
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := lc.get(ctx, "/a", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the waiting caller to give up with its context", err)
+	}
+}
+
+func TestClientCacheInvalidation(t *testing.T) {
+	tests := map[string]struct {
+		between   func(ctx context.Context, c *Client) error
+		wantLists int32
+	}{
+		"update": {
+			between: func(ctx context.Context, c *Client) error {
+				_, err := c.UpdateNetwork(ctx, "net1", &Network{Name: "LAN"})
+				return err
+			},
+			wantLists: 2,
+		},
+		"delete": {
+			between:   func(ctx context.Context, c *Client) error { return c.DeleteNetwork(ctx, "net1") },
+			wantLists: 2,
+		},
+		"command": {
+			between:   func(ctx context.Context, c *Client) error { return c.RestartDevice(ctx, "aa:bb:cc:dd:ee:ff", false) },
+			wantLists: 2,
+		},
+		"read": {
+			between: func(ctx context.Context, c *Client) error {
+				_, err := c.GetNetwork(ctx, "net1")
+				return err
+			},
+			wantLists: 1,
+		},
+		"read-only command": {
+			between: func(ctx context.Context, c *Client) error {
+				_, err := c.ListAdmins(ctx)
+				return err
+			},
+			wantLists: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lists atomic.Int32
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "GET" && r.URL.Path == "/api/s/default/rest/networkconf" {
+					lists.Add(1)
+				}
+				writeData(w, []map[string]string{{"_id": "net1", "name": "LAN"}})
+			}), TransportConfig{})
+			ctx := context.Background()
+
+			if _, err := c.ListNetworks(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := tc.between(ctx, c); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if _, err := c.ListNetworks(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := lists.Load(); got != tc.wantLists {
+				t.Errorf("got %d list requests, want %d", got, tc.wantLists)
+			}
+		})
+	}
+}
@@ -2,17 +2,20 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
@@ -27,9 +30,21 @@ type Client struct {
 	mu        sync.RWMutex
 	csrfToken string
 	loggedIn  bool
+	sysInfo   *SysInfo
+
+	slots chan struct{}
+	cache listCache
+
+	voucherMu sync.Mutex
 }
 
-func NewClient(host, username, password, apiKey, site string, insecure, isStandalone bool) (*Client, error) {
+// standaloneCache remembers the detected controller type per host so that
+// several provider configurations for the same host probe it only once.
+var standaloneCache sync.Map
+
+// NewClient creates a client and logs in unless an API key is given. When
+// isStandalone is nil the controller type is detected from the host.
+func NewClient(host, username, password, apiKey, site string, isStandalone *bool, transport TransportConfig) (*Client, error) {
 	if site == "" {
 		site = "default"
 	}
@@ -44,22 +59,51 @@ func NewClient(host, username, password, apiKey, site string, insecure, isStanda
 	retryClient.RetryWaitMin = 1 * time.Second
 	retryClient.RetryWaitMax = 30 * time.Second
 	retryClient.Logger = nil
+	retryClient.RequestLogHook = logRetry
+	retryClient.CheckRetry = checkRetry
+	retryClient.Backoff = backoff
+	if transport.RetryMax != nil {
+		retryClient.RetryMax = *transport.RetryMax
+	}
+	if transport.RetryWaitMin > 0 {
+		retryClient.RetryWaitMin = transport.RetryWaitMin
+	}
+	if transport.RetryWaitMax > 0 {
+		retryClient.RetryWaitMax = transport.RetryWaitMax
+	}
 
-	tr := &http.Transport{}
-	if insecure {
-		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	tr, err := newTransport(transport)
+	if err != nil {
+		return nil, fmt.Errorf("configuring transport: %w", err)
 	}
 	retryClient.HTTPClient.Transport = tr
+	if limiter := newRateLimiter(transport.RequestsPerSecond); limiter != nil {
+		retryClient.HTTPClient.Transport = &rateLimitedTransport{base: tr, limiter: limiter}
+	}
 	retryClient.HTTPClient.Jar = jar
+	retryClient.HTTPClient.Timeout = transport.Timeout
 
 	c := &Client{
-		Site:         site,
-		BaseURL:      host,
-		APIKey:       apiKey,
-		IsStandalone: isStandalone,
-		HTTPClient:   retryClient,
-		username:     username,
-		password:     password,
+		Site:       site,
+		BaseURL:    host,
+		APIKey:     apiKey,
+		HTTPClient: retryClient,
+		username:   username,
+		password:   password,
+	}
+
+	if transport.MaxConcurrentRequests > 0 {
+		c.slots = make(chan struct{}, transport.MaxConcurrentRequests)
+	}
+
+	if isStandalone != nil {
+		c.IsStandalone = *isStandalone
+	} else {
+		standalone, err := c.detectStandalone(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("detecting controller type (set is_standalone to skip detection): %w", err)
+		}
+		c.IsStandalone = standalone
 	}
 
 	if apiKey == "" {
@@ -76,16 +120,23 @@ func (c *Client) Login(ctx context.Context) error {
 		return nil
 	}
 
-	payload := map[string]string{
+	payload := map[string]any{
 		"username": c.username,
 		"password": c.password,
 	}
+	// UniFi OS consoles authenticate against the OS; standalone Network
+	// applications use the legacy controller login.
+	loginURL := c.BaseURL + "/api/auth/login"
+	if c.IsStandalone {
+		loginURL = c.BaseURL + "/api/login"
+		payload["remember"] = true
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("marshaling login payload: %w", err)
 	}
 
-	loginURL := c.BaseURL + "/api/auth/login"
 	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", loginURL, body)
 	if err != nil {
 		return fmt.Errorf("creating login request: %w", err)
@@ -98,6 +149,11 @@ func (c *Client) Login(ctx context.Context) error {
 	}
 	defer resp.Body.Close()
 
+	tflog.SubsystemDebug(withLogSubsystem(ctx), logSubsystem, "UniFi login request", map[string]any{
+		"url":    loginURL,
+		"status": resp.StatusCode,
+	})
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("login failed with status: %d", resp.StatusCode)
 	}
@@ -112,12 +168,61 @@ func (c *Client) Login(ctx context.Context) error {
 	return nil
 }
 
-func (c *Client) fetchCSRFToken(ctx context.Context) (string, error) {
-	path := "/api/s/" + url.PathEscape(c.Site) + "/self"
-	if !c.IsStandalone {
-		path = "/proxy/network" + path
+// detectStandalone probes the host to tell a UniFi OS console from a
+// standalone Network application. UniFi OS serves its own UI at the root,
+// whereas a standalone controller redirects to /manage.
+func (c *Client) detectStandalone(ctx context.Context) (bool, error) {
+	if cached, ok := standaloneCache.Load(c.BaseURL); ok {
+		return cached.(bool), nil
 	}
-	csrfURL := c.BaseURL + path
+
+	probe := *c.HTTPClient.HTTPClient
+	probe.Jar = nil
+	probe.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	status := func(path string) (int, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", c.BaseURL+path, nil)
+		if err != nil {
+			return 0, err
+		}
+		resp, err := probe.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+
+	code, err := status("/")
+	if err != nil {
+		return false, err
+	}
+
+	var standalone bool
+	switch {
+	case code == http.StatusOK:
+		standalone = false
+	case code >= 300 && code < 400:
+		standalone = true
+	default:
+		// Fall back to the unauthenticated status endpoint, which UniFi OS
+		// only serves behind the /proxy/network prefix.
+		code, err = status("/proxy/network/status")
+		if err != nil {
+			return false, err
+		}
+		standalone = code != http.StatusOK
+	}
+
+	standaloneCache.Store(c.BaseURL, standalone)
+	return standalone, nil
+}
+
+func (c *Client) fetchCSRFToken(ctx context.Context) (string, error) {
+	csrfURL := c.BaseURL + c.sitePath("self")
 	req, err := http.NewRequestWithContext(ctx, "GET", csrfURL, nil)
 	if err != nil {
 		return "", err
@@ -133,6 +238,10 @@ func (c *Client) fetchCSRFToken(ctx context.Context) (string, error) {
 }
 
 func (c *Client) doRequest(ctx context.Context, method, path string, body any, result any) error {
+	ctx = withLogSubsystem(ctx)
+	if method == "POST" {
+		ctx = withNonIdempotent(ctx)
+	}
 	reqURL := c.BaseURL + path
 
 	var bodyBytes []byte
@@ -165,23 +274,53 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any, r
 		}
 	}
 
+	tflog.SubsystemTrace(ctx, logSubsystem, "Sending UniFi API request", map[string]any{
+		"method":  method,
+		"path":    path,
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(bodyBytes),
+	})
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return deadlineError(ctx, method, path, err)
+	}
+	defer release()
+
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		tflog.SubsystemDebug(ctx, logSubsystem, "UniFi API request failed", map[string]any{
+			"method":      method,
+			"path":        path,
+			"duration_ms": time.Since(start).Milliseconds(),
+			"error":       err.Error(),
+		})
+		return deadlineError(ctx, method, path, err)
 	}
 	defer resp.Body.Close()
 
+	bodyContent, err := io.ReadAll(resp.Body)
+	tflog.SubsystemDebug(ctx, logSubsystem, "UniFi API request", map[string]any{
+		"method":      method,
+		"path":        path,
+		"status":      resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+	if err != nil {
+		return deadlineError(ctx, method, path, fmt.Errorf("reading response body: %w", err))
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received UniFi API response", map[string]any{
+		"method": method,
+		"path":   path,
+		"body":   redactBody(bodyContent),
+	})
+
 	if resp.StatusCode >= 400 {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unifi api error (status %d): %s", resp.StatusCode, string(respBody))
+		return fmt.Errorf("unifi api error (status %d): %s", resp.StatusCode, string(bodyContent))
 	}
 
 	if result != nil {
-		bodyContent, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return err
-		}
-
 		var apiResp struct {
 			Meta struct {
 				RC string `json:"rc"`
@@ -199,20 +338,73 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any, r
 	return nil
 }
 
-func (c *Client) doREST(ctx context.Context, method, endpoint string, body, result any) error {
-	path := "/api/s/" + url.PathEscape(c.Site) + "/rest/" + endpoint
+// deadlineError explains an error caused by the operation's timeout expiring,
+// which would otherwise surface as a bare "context deadline exceeded".
+func deadlineError(ctx context.Context, method, path string, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s %s did not complete before the operation timed out; increase the resource's timeouts if the controller needs longer: %w", method, path, err)
+	}
+	return err
+}
+
+// sitePath returns the legacy site-scoped API path for endpoint, including
+// the /proxy/network prefix on UniFi OS consoles.
+func (c *Client) sitePath(endpoint string) string {
+	path := "/api/s/" + url.PathEscape(c.Site) + "/" + endpoint
 	if !c.IsStandalone {
 		path = "/proxy/network" + path
 	}
+	return path
+}
+
+// controllerPath returns the controller-wide (not site-scoped) API path for
+// endpoint.
+func (c *Client) controllerPath(endpoint string) string {
+	path := "/api/" + endpoint
+	if !c.IsStandalone {
+		path = "/proxy/network" + path
+	}
+	return path
+}
+
+// doWrite sends a request that may change controller state. A write can
+// change any cached list, so the cache is cleared once it completes.
+func (c *Client) doWrite(ctx context.Context, method, path string, body, result any) error {
+	defer c.cache.invalidate()
 	return c.doRequest(ctx, method, path, body, result)
 }
 
+func (c *Client) doREST(ctx context.Context, method, endpoint string, body, result any) error {
+	if method == "GET" {
+		return c.doRequest(ctx, method, c.sitePath("rest/"+endpoint), body, result)
+	}
+	return c.doWrite(ctx, method, c.sitePath("rest/"+endpoint), body, result)
+}
+
+// doCmd runs a command that changes controller state.
+func (c *Client) doCmd(ctx context.Context, manager string, payload, result any) error {
+	return c.doWrite(ctx, "POST", c.sitePath("cmd/"+manager), payload, result)
+}
+
+// doQuery runs a read-only command, which leaves the cache intact.
+func (c *Client) doQuery(ctx context.Context, manager string, payload, result any) error {
+	return c.doRequest(ctx, "POST", c.sitePath("cmd/"+manager), payload, result)
+}
+
 func (c *Client) doV2(ctx context.Context, method, endpoint string, body, result any) error {
+	if method == "GET" {
+		return c.doRequest(ctx, method, c.v2Path(endpoint), body, result)
+	}
+	return c.doWrite(ctx, method, c.v2Path(endpoint), body, result)
+}
+
+// v2Path returns the site-scoped v2 API path for endpoint.
+func (c *Client) v2Path(endpoint string) string {
 	path := "/v2/api/site/" + url.PathEscape(c.Site) + "/" + endpoint
 	if !c.IsStandalone {
 		path = "/proxy/network" + path
 	}
-	return c.doRequest(ctx, method, path, body, result)
+	return path
 }
 
 // Generic CRUD Helpers
@@ -240,11 +432,7 @@ func getResource[T any](ctx context.Context, c *Client, endpoint, id string) (*T
 }
 
 func listResources[T any](ctx context.Context, c *Client, endpoint string) ([]T, error) {
-	var items []T
-	if err := c.doREST(ctx, "GET", endpoint, nil, &items); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return cachedList[T](ctx, c, c.sitePath("rest/"+endpoint))
 }
 
 func updateResource[T any](ctx context.Context, c *Client, endpoint, id string, item *T) (*T, error) {
@@ -262,6 +450,31 @@ func deleteResource(ctx context.Context, c *Client, endpoint, id string) error {
 	return c.doREST(ctx, "DELETE", endpoint+"/"+id, nil, nil)
 }
 
+// Site settings are singletons read from get/setting/<key> and written to
+// set/setting/<key>; the controller merges the fields sent on update.
+
+func getSetting[T any](ctx context.Context, c *Client, key string) (*T, error) {
+	var items []T
+	if err := c.doRequest(ctx, "GET", c.sitePath("get/setting/"+key), nil, &items); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("setting %q not found", key)
+	}
+	return &items[0], nil
+}
+
+func updateSetting[T any](ctx context.Context, c *Client, key string, item *T) (*T, error) {
+	var items []T
+	if err := c.doWrite(ctx, "PUT", c.sitePath("set/setting/"+key), item, &items); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return getSetting[T](ctx, c, key)
+	}
+	return &items[0], nil
+}
+
 // Resource Methods
 
 func (c *Client) CreateNetwork(ctx context.Context, network *Network) (*Network, error) {
@@ -292,6 +505,10 @@ func (c *Client) GetFirewallRule(ctx context.Context, id string) (*FirewallRule,
 	return getResource[FirewallRule](ctx, c, "firewallrule", id)
 }
 
+func (c *Client) ListFirewallRules(ctx context.Context) ([]FirewallRule, error) {
+	return listResources[FirewallRule](ctx, c, "firewallrule")
+}
+
 func (c *Client) UpdateFirewallRule(ctx context.Context, id string, rule *FirewallRule) (*FirewallRule, error) {
 	return updateResource(ctx, c, "firewallrule", id, rule)
 }
@@ -340,6 +557,26 @@ func (c *Client) DeleteUserGroup(ctx context.Context, id string) error {
 	return deleteResource(ctx, c, "usergroup", id)
 }
 
+func (c *Client) CreateHotspotOperator(ctx context.Context, operator *HotspotOperator) (*HotspotOperator, error) {
+	return createResource(ctx, c, "hotspotop", operator)
+}
+
+func (c *Client) GetHotspotOperator(ctx context.Context, id string) (*HotspotOperator, error) {
+	return getResource[HotspotOperator](ctx, c, "hotspotop", id)
+}
+
+func (c *Client) ListHotspotOperators(ctx context.Context) ([]HotspotOperator, error) {
+	return listResources[HotspotOperator](ctx, c, "hotspotop")
+}
+
+func (c *Client) UpdateHotspotOperator(ctx context.Context, id string, operator *HotspotOperator) (*HotspotOperator, error) {
+	return updateResource(ctx, c, "hotspotop", id, operator)
+}
+
+func (c *Client) DeleteHotspotOperator(ctx context.Context, id string) error {
+	return deleteResource(ctx, c, "hotspotop", id)
+}
+
 type apGroupCreateRequest struct {
 	Name        string   `json:"name"`
 	DeviceMACs  []string `json:"device_macs"`
@@ -379,9 +616,7 @@ func (c *Client) GetAPGroup(ctx context.Context, id string) (*APGroup, error) {
 }
 
 func (c *Client) ListAPGroups(ctx context.Context) ([]APGroup, error) {
-	var groups []APGroup
-	err := c.doV2(ctx, "GET", "apgroups", nil, &groups)
-	return groups, err
+	return cachedList[APGroup](ctx, c, c.v2Path("apgroups"))
 }
 
 func (c *Client) UpdateAPGroup(ctx context.Context, id string, group *APGroup) (*APGroup, error) {
@@ -477,11 +712,41 @@ func (c *Client) DeleteUser(ctx context.Context, mac string) error {
 		"cmd":  "forget-sta",
 		"macs": []string{mac},
 	}
-	path := "/api/s/" + url.PathEscape(c.Site) + "/cmd/stamgr"
-	if !c.IsStandalone {
-		path = "/proxy/network" + path
+	return c.doCmd(ctx, "stamgr", payload, nil)
+}
+
+// KickClient disconnects a client, which may reconnect straight away.
+func (c *Client) KickClient(ctx context.Context, mac string) error {
+	payload := map[string]any{
+		"cmd": "kick-sta",
+		"mac": mac,
+	}
+	return c.doCmd(ctx, "stamgr", payload, nil)
+}
+
+// BlockClient blocks a client from the network, or unblocks it.
+func (c *Client) BlockClient(ctx context.Context, mac string, blocked bool) error {
+	cmd := "block-sta"
+	if !blocked {
+		cmd = "unblock-sta"
+	}
+	payload := map[string]any{
+		"cmd": cmd,
+		"mac": mac,
 	}
-	return c.doRequest(ctx, "POST", path, payload, nil)
+	return c.doCmd(ctx, "stamgr", payload, nil)
+}
+
+// ListUsers returns the clients known to the site (rest/user), including offline ones.
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	return listResources[User](ctx, c, "user")
+}
+
+// ListActiveClients returns the clients currently connected to the site (stat/sta).
+func (c *Client) ListActiveClients(ctx context.Context) ([]ActiveClient, error) {
+	var clients []ActiveClient
+	err := c.doRequest(ctx, "GET", c.sitePath("stat/sta"), nil, &clients)
+	return clients, err
 }
 
 func (c *Client) CreateRADIUSProfile(ctx context.Context, profile *RADIUSProfile) (*RADIUSProfile, error) {
@@ -512,6 +777,10 @@ func (c *Client) GetPortForward(ctx context.Context, id string) (*PortForward, e
 	return getResource[PortForward](ctx, c, "portforward", id)
 }
 
+func (c *Client) ListPortForwards(ctx context.Context) ([]PortForward, error) {
+	return listResources[PortForward](ctx, c, "portforward")
+}
+
 func (c *Client) UpdatePortForward(ctx context.Context, id string, forward *PortForward) (*PortForward, error) {
 	return updateResource(ctx, c, "portforward", id, forward)
 }
@@ -549,6 +818,23 @@ func (c *Client) GetStaticRoute(ctx context.Context, id string) (*Routing, error
 	return getResource[Routing](ctx, c, "routing", id)
 }
 
+// ListStaticRoutes returns the static routes of the site, leaving out the
+// other entries of rest/routing.
+func (c *Client) ListStaticRoutes(ctx context.Context) ([]Routing, error) {
+	routes, err := listResources[Routing](ctx, c, "routing")
+	if err != nil {
+		return nil, err
+	}
+
+	var static []Routing
+	for _, r := range routes {
+		if r.Type == "static-route" {
+			static = append(static, r)
+		}
+	}
+	return static, nil
+}
+
 func (c *Client) UpdateStaticRoute(ctx context.Context, id string, route *Routing) (*Routing, error) {
 	req := map[string]any{
 		"_id":                   id,
@@ -635,9 +921,7 @@ func (c *Client) GetStaticDNS(ctx context.Context, id string) (*StaticDNS, error
 }
 
 func (c *Client) ListStaticDNS(ctx context.Context) ([]StaticDNS, error) {
-	var records []StaticDNS
-	err := c.doV2(ctx, "GET", "static-dns", nil, &records)
-	return records, err
+	return cachedList[StaticDNS](ctx, c, c.v2Path("static-dns"))
 }
 
 func (c *Client) UpdateStaticDNS(ctx context.Context, id string, record *StaticDNS) (*StaticDNS, error) {
@@ -684,12 +968,15 @@ func (c *Client) DeleteStaticDNS(ctx context.Context, id string) error {
 
 func (c *Client) CreateTrafficRule(ctx context.Context, rule *TrafficRule) (*TrafficRule, error) {
 	req := map[string]any{
-		"name":            rule.Name,
-		"action":          rule.Action,
-		"matching_target": rule.MatchingTarget,
-		"description":     rule.Description,
-		"enabled":         true,
-		"target_devices":  rule.TargetDevices,
+		"name":             rule.Name,
+		"action":           rule.Action,
+		"matching_target":  rule.MatchingTarget,
+		"description":      rule.Description,
+		"enabled":          true,
+		"target_devices":   rule.TargetDevices,
+		"app_ids":          emptyIfNil(rule.AppIDs),
+		"app_category_ids": emptyIfNil(rule.AppCategoryIDs),
+		"regions":          emptyIfNil(rule.Regions),
 	}
 	if rule.Enabled != nil {
 		req["enabled"] = *rule.Enabled
@@ -719,19 +1006,20 @@ func (c *Client) GetTrafficRule(ctx context.Context, id string) (*TrafficRule, e
 }
 
 func (c *Client) ListTrafficRules(ctx context.Context) ([]TrafficRule, error) {
-	var rules []TrafficRule
-	err := c.doV2(ctx, "GET", "trafficrules", nil, &rules)
-	return rules, err
+	return cachedList[TrafficRule](ctx, c, c.v2Path("trafficrules"))
 }
 
 func (c *Client) UpdateTrafficRule(ctx context.Context, id string, rule *TrafficRule) (*TrafficRule, error) {
 	req := map[string]any{
-		"name":            rule.Name,
-		"action":          rule.Action,
-		"matching_target": rule.MatchingTarget,
-		"description":     rule.Description,
-		"enabled":         true,
-		"target_devices":  rule.TargetDevices,
+		"name":             rule.Name,
+		"action":           rule.Action,
+		"matching_target":  rule.MatchingTarget,
+		"description":      rule.Description,
+		"enabled":          true,
+		"target_devices":   rule.TargetDevices,
+		"app_ids":          emptyIfNil(rule.AppIDs),
+		"app_category_ids": emptyIfNil(rule.AppCategoryIDs),
+		"regions":          emptyIfNil(rule.Regions),
 	}
 	if rule.Enabled != nil {
 		req["enabled"] = *rule.Enabled
@@ -748,3 +1036,418 @@ func (c *Client) UpdateTrafficRule(ctx context.Context, id string, rule *Traffic
 func (c *Client) DeleteTrafficRule(ctx context.Context, id string) error {
 	return c.doV2(ctx, "DELETE", "trafficrules/"+id, nil, nil)
 }
+
+// emptyIfNil returns an empty slice for nil so it encodes as [] rather than null.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func (c *Client) GetSettingIPS(ctx context.Context) (*SettingIPS, error) {
+	return getSetting[SettingIPS](ctx, c, "ips")
+}
+
+func (c *Client) UpdateSettingIPS(ctx context.Context, setting *SettingIPS) (*SettingIPS, error) {
+	setting.Key = "ips"
+	return updateSetting(ctx, c, "ips", setting)
+}
+
+func (c *Client) GetSettingDPI(ctx context.Context) (*SettingDPI, error) {
+	return getSetting[SettingDPI](ctx, c, "dpi")
+}
+
+func (c *Client) UpdateSettingDPI(ctx context.Context, setting *SettingDPI) (*SettingDPI, error) {
+	setting.Key = "dpi"
+	return updateSetting(ctx, c, "dpi", setting)
+}
+
+func (c *Client) CreateContentFilter(ctx context.Context, filter *ContentFilter) (*ContentFilter, error) {
+	var created ContentFilter
+	err := c.doV2(ctx, "POST", "content-filtering", filter, &created)
+	return &created, err
+}
+
+func (c *Client) GetContentFilter(ctx context.Context, id string) (*ContentFilter, error) {
+	var filter ContentFilter
+	err := c.doV2(ctx, "GET", "content-filtering/"+id, nil, &filter)
+	if err != nil {
+		filters, _ := c.ListContentFilters(ctx)
+		for _, f := range filters {
+			if f.ID == id {
+				return &f, nil
+			}
+		}
+		return nil, err
+	}
+	return &filter, nil
+}
+
+func (c *Client) ListContentFilters(ctx context.Context) ([]ContentFilter, error) {
+	return cachedList[ContentFilter](ctx, c, c.v2Path("content-filtering"))
+}
+
+func (c *Client) UpdateContentFilter(ctx context.Context, id string, filter *ContentFilter) (*ContentFilter, error) {
+	var updated ContentFilter
+	err := c.doV2(ctx, "PUT", "content-filtering/"+id, filter, &updated)
+	return &updated, err
+}
+
+func (c *Client) DeleteContentFilter(ctx context.Context, id string) error {
+	return c.doV2(ctx, "DELETE", "content-filtering/"+id, nil, nil)
+}
+
+func (c *Client) GetSettingGuestAccess(ctx context.Context) (*SettingGuestAccess, error) {
+	return getSetting[SettingGuestAccess](ctx, c, "guest_access")
+}
+
+func (c *Client) UpdateSettingGuestAccess(ctx context.Context, setting *SettingGuestAccess) (*SettingGuestAccess, error) {
+	setting.Key = "guest_access"
+	return updateSetting(ctx, c, "guest_access", setting)
+}
+
+// CreateVouchers creates a batch of hotspot vouchers and returns them. The
+// controller only reports the create_time of the batch, which has one-second
+// resolution and is shared by batches created in the same second, so the new
+// vouchers are told apart by the voucher IDs that existed before. Creation is
+// serialized per client so that concurrent batches cannot claim each other's
+// vouchers.
+func (c *Client) CreateVouchers(ctx context.Context, batch *VoucherBatch) ([]Voucher, error) {
+	c.voucherMu.Lock()
+	defer c.voucherMu.Unlock()
+
+	existing, err := c.ListVouchers(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		known[v.ID] = true
+	}
+
+	payload := map[string]any{
+		"cmd":    "create-voucher",
+		"n":      batch.Count,
+		"expire": batch.Duration,
+		"quota":  batch.Quota,
+		"note":   batch.Note,
+	}
+	if batch.Up != nil {
+		payload["up"] = *batch.Up
+	}
+	if batch.Down != nil {
+		payload["down"] = *batch.Down
+	}
+	if batch.Bytes != nil {
+		payload["bytes"] = *batch.Bytes
+	}
+
+	var created []struct {
+		CreateTime int64 `json:"create_time"`
+	}
+	if err := c.doCmd(ctx, "hotspot", payload, &created); err != nil {
+		return nil, err
+	}
+	if len(created) == 0 {
+		return nil, fmt.Errorf("empty response from unifi")
+	}
+
+	vouchers, err := c.ListVouchers(ctx, created[0].CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	var fresh []Voucher
+	for _, v := range vouchers {
+		if !known[v.ID] {
+			fresh = append(fresh, v)
+		}
+	}
+	return fresh, nil
+}
+
+// ListVouchers returns the vouchers of the batch created at createTime, or
+// all vouchers when createTime is zero.
+func (c *Client) ListVouchers(ctx context.Context, createTime int64) ([]Voucher, error) {
+	var vouchers []Voucher
+	if err := c.doRequest(ctx, "GET", c.sitePath("stat/voucher"), nil, &vouchers); err != nil {
+		return nil, err
+	}
+	if createTime == 0 {
+		return vouchers, nil
+	}
+
+	var batch []Voucher
+	for _, v := range vouchers {
+		if v.CreateTime == createTime {
+			batch = append(batch, v)
+		}
+	}
+	return batch, nil
+}
+
+func (c *Client) DeleteVoucher(ctx context.Context, id string) error {
+	payload := map[string]any{
+		"cmd": "delete-voucher",
+		"_id": id,
+	}
+	return c.doCmd(ctx, "hotspot", payload, nil)
+}
+
+// ListAdmins returns the administrators of the current site.
+func (c *Client) ListAdmins(ctx context.Context) ([]Admin, error) {
+	var admins []Admin
+	err := c.doQuery(ctx, "sitemgr", map[string]any{"cmd": "get-admins"}, &admins)
+	return admins, err
+}
+
+// ListAllAdmins returns the administrators of every site on the controller.
+func (c *Client) ListAllAdmins(ctx context.Context) ([]Admin, error) {
+	var admins []Admin
+	err := c.doRequest(ctx, "GET", c.controllerPath("stat/admin"), nil, &admins)
+	return admins, err
+}
+
+func (c *Client) GetAdmin(ctx context.Context, id string) (*Admin, error) {
+	admins, err := c.ListAdmins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range admins {
+		if a.ID == id {
+			return &a, nil
+		}
+	}
+	return nil, fmt.Errorf("admin %q not found on site %q", id, c.Site)
+}
+
+// InviteAdmin invites a new administrator to the current site by email.
+func (c *Client) InviteAdmin(ctx context.Context, admin *Admin) error {
+	payload := map[string]any{
+		"cmd":         "invite-admin",
+		"name":        admin.Name,
+		"email":       admin.Email,
+		"role":        admin.Role,
+		"permissions": admin.permissionsOrEmpty(),
+		"for_sso":     false,
+	}
+	return c.doCmd(ctx, "sitemgr", payload, nil)
+}
+
+// GrantAdmin grants an existing controller administrator access to the current site.
+func (c *Client) GrantAdmin(ctx context.Context, admin *Admin) error {
+	payload := map[string]any{
+		"cmd":         "grant-admin",
+		"admin":       admin.ID,
+		"role":        admin.Role,
+		"permissions": admin.permissionsOrEmpty(),
+	}
+	return c.doCmd(ctx, "sitemgr", payload, nil)
+}
+
+func (c *Client) UpdateAdmin(ctx context.Context, admin *Admin) error {
+	payload := map[string]any{
+		"cmd":         "update-admin",
+		"admin":       admin.ID,
+		"name":        admin.Name,
+		"email":       admin.Email,
+		"role":        admin.Role,
+		"permissions": admin.permissionsOrEmpty(),
+	}
+	return c.doCmd(ctx, "sitemgr", payload, nil)
+}
+
+// RevokeAdmin removes an administrator's access to the current site.
+func (c *Client) RevokeAdmin(ctx context.Context, id string) error {
+	payload := map[string]any{
+		"cmd":   "revoke-admin",
+		"admin": id,
+	}
+	return c.doCmd(ctx, "sitemgr", payload, nil)
+}
+
+// ListDevices returns the devices adopted by the current site.
+func (c *Client) ListDevices(ctx context.Context) ([]Device, error) {
+	var devices []Device
+	err := c.doRequest(ctx, "GET", c.sitePath("stat/device"), nil, &devices)
+	return devices, err
+}
+
+// RestartDevice reboots a device. A hard restart also cuts PoE power to the
+// devices a switch powers.
+func (c *Client) RestartDevice(ctx context.Context, mac string, hard bool) error {
+	rebootType := "soft"
+	if hard {
+		rebootType = "hard"
+	}
+	payload := map[string]any{
+		"cmd":         "restart",
+		"mac":         mac,
+		"reboot_type": rebootType,
+	}
+	return c.doCmd(ctx, "devmgr", payload, nil)
+}
+
+// ForceProvisionDevice pushes the current configuration to a device again.
+func (c *Client) ForceProvisionDevice(ctx context.Context, mac string) error {
+	payload := map[string]any{
+		"cmd": "force-provision",
+		"mac": mac,
+	}
+	return c.doCmd(ctx, "devmgr", payload, nil)
+}
+
+// LocateDevice starts or stops blinking a device's LED.
+func (c *Client) LocateDevice(ctx context.Context, mac string, enabled bool) error {
+	cmd := "set-locate"
+	if !enabled {
+		cmd = "unset-locate"
+	}
+	payload := map[string]any{
+		"cmd": cmd,
+		"mac": mac,
+	}
+	return c.doCmd(ctx, "devmgr", payload, nil)
+}
+
+// PowerCycleSwitchPort briefly cuts PoE power on a switch port.
+func (c *Client) PowerCycleSwitchPort(ctx context.Context, mac string, portIdx int) error {
+	payload := map[string]any{
+		"cmd":      "power-cycle",
+		"mac":      mac,
+		"port_idx": portIdx,
+	}
+	return c.doCmd(ctx, "devmgr", payload, nil)
+}
+
+// UpgradeDevice upgrades a device to the latest firmware the controller
+// offers, or to the firmware at url when given.
+func (c *Client) UpgradeDevice(ctx context.Context, mac, url string) error {
+	payload := map[string]any{
+		"cmd": "upgrade",
+		"mac": mac,
+	}
+	if url != "" {
+		payload["cmd"] = "upgrade-external"
+		payload["url"] = url
+	}
+	return c.doCmd(ctx, "devmgr", payload, nil)
+}
+
+// SysInfo returns the controller version and host details. The result is
+// cached for the lifetime of the client.
+func (c *Client) SysInfo(ctx context.Context) (*SysInfo, error) {
+	c.mu.RLock()
+	cached := c.sysInfo
+	c.mu.RUnlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	var infos []SysInfo
+	if err := c.doRequest(ctx, "GET", c.sitePath("stat/sysinfo"), nil, &infos); err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("empty sysinfo response from unifi")
+	}
+	info := infos[0]
+
+	if !c.IsStandalone {
+		info.IsUniFiOS = true
+		// /api/system is served by UniFi OS itself; the console details are
+		// informational, so a failure here is not fatal.
+		var system consoleSystem
+		if err := c.doRequest(ctx, "GET", "/api/system", nil, &system); err == nil {
+			info.ConsoleName = system.Name
+			info.ConsoleHardware = system.Hardware.ShortName
+		}
+	}
+
+	c.mu.Lock()
+	c.sysInfo = &info
+	c.mu.Unlock()
+
+	return &info, nil
+}
+
+// RequireVersion returns an error naming feature when the controller runs a
+// Network application older than min.
+func (c *Client) RequireVersion(ctx context.Context, feature, min string) error {
+	info, err := c.SysInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if info.AtLeast(min) {
+		return nil
+	}
+	return &VersionError{Feature: feature, Required: min, Actual: info.Version}
+}
+
+// VersionError reports a feature that the controller's Network application
+// version does not support.
+type VersionError struct {
+	Feature  string
+	Required string
+	Actual   string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s requires UniFi Network %s or later, but the controller runs %s", e.Feature, e.Required, e.Actual)
+}
+
+// compareVersions compares dotted numeric versions, ignoring any suffix
+// after a non-numeric character (e.g. "9.0.114-beta").
+func compareVersions(a, b string) int {
+	pa, pb := versionParts(a), versionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	var parts []int
+	for _, field := range strings.Split(v, ".") {
+		end := 0
+		for end < len(field) && field[end] >= '0' && field[end] <= '9' {
+			end++
+		}
+		n, err := strconv.Atoi(field[:end])
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+		if end < len(field) {
+			break
+		}
+	}
+	return parts
+}
+
+// ListDPIApps returns the controller's DPI application catalogue.
+func (c *Client) ListDPIApps(ctx context.Context) ([]DPIApp, error) {
+	return cachedList[DPIApp](ctx, c, c.v2Path("dpi/apps"))
+}
+
+// ListDPICategories returns the controller's DPI category catalogue.
+func (c *Client) ListDPICategories(ctx context.Context) ([]DPICategory, error) {
+	return cachedList[DPICategory](ctx, c, c.v2Path("dpi/categories"))
+}
+
+// ListRegions returns the country codes supported by the controller (stat/ccode).
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	return cachedList[Region](ctx, c, c.sitePath("stat/ccode"))
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestClient returns an API-key client for a standalone controller served
+// by handler.
+func newTestClient(t *testing.T, handler http.Handler, transport TransportConfig) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	standalone := true
+	c, err := NewClient(srv.URL, "", "", "key", "default", &standalone, transport)
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	return c
+}
+
+// writeData writes data in the controller's {"meta": ..., "data": ...} envelope.
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"meta": map[string]string{"rc": "ok"},
+		"data": data,
+	})
+}
+
+func TestDetectStandalone(t *testing.T) {
+	tests := map[string]struct {
+		root       int
+		status     int
+		want       bool
+		wantProbes []string
+	}{
+		"unifi os":            {root: http.StatusOK, want: false, wantProbes: []string{"/"}},
+		"standalone redirect": {root: http.StatusFound, want: true, wantProbes: []string{"/"}},
+		"fallback unifi os":   {root: http.StatusUnauthorized, status: http.StatusOK, want: false, wantProbes: []string{"/", "/proxy/network/status"}},
+		"fallback standalone": {root: http.StatusNotFound, status: http.StatusNotFound, want: true, wantProbes: []string{"/", "/proxy/network/status"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var probes []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				probes = append(probes, r.URL.Path)
+				switch r.URL.Path {
+				case "/":
+					if tc.root >= 300 && tc.root < 400 {
+						w.Header().Set("Location", "/manage")
+					}
+					w.WriteHeader(tc.root)
+				case "/proxy/network/status":
+					w.WriteHeader(tc.status)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			c, err := NewClient(srv.URL, "", "", "key", "default", nil, TransportConfig{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if c.IsStandalone != tc.want {
+				t.Errorf("got standalone %t, want %t", c.IsStandalone, tc.want)
+			}
+			if len(probes) != len(tc.wantProbes) {
+				t.Fatalf("got probes %q, want %q", probes, tc.wantProbes)
+			}
+			for i := range probes {
+				if probes[i] != tc.wantProbes[i] {
+					t.Errorf("got probes %q, want %q", probes, tc.wantProbes)
+				}
+			}
+
+			// A second client for the same host reuses the detected type.
+			if _, err := NewClient(srv.URL, "", "", "key", "default", nil, TransportConfig{}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(probes) != len(tc.wantProbes) {
+				t.Errorf("host probed again: %q", probes)
+			}
+		})
+	}
+}
+
+func TestDetectStandaloneOverride(t *testing.T) {
+	var probes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+	}))
+	defer srv.Close()
+
+	for _, standalone := range []bool{true, false} {
+		c, err := NewClient(srv.URL, "", "", "key", "default", &standalone, TransportConfig{})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if c.IsStandalone != standalone {
+			t.Errorf("got standalone %t, want %t", c.IsStandalone, standalone)
+		}
+	}
+	if n := probes.Load(); n != 0 {
+		t.Errorf("is_standalone set, but the host was probed %d times", n)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := map[string]struct {
+		standalone   bool
+		loginStatus  int
+		wantPath     string
+		wantRemember bool
+		wantSelf     string
+		wantErr      bool
+	}{
+		"unifi os":     {loginStatus: http.StatusOK, wantPath: "/api/auth/login", wantSelf: "/proxy/network/api/s/default/self"},
+		"standalone":   {standalone: true, loginStatus: http.StatusOK, wantPath: "/api/login", wantRemember: true, wantSelf: "/api/s/default/self"},
+		"unauthorized": {standalone: true, loginStatus: http.StatusUnauthorized, wantPath: "/api/login", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var loginPath string
+			var payload map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/login", "/api/auth/login":
+					loginPath = r.URL.Path
+					if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+						t.Errorf("decoding login payload: %s", err)
+					}
+					w.WriteHeader(tc.loginStatus)
+				case tc.wantSelf:
+					w.Header().Set("X-Csrf-Token", "token")
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			c, err := NewClient(srv.URL, "admin", "secret", "", "default", &tc.standalone, TransportConfig{})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if loginPath != tc.wantPath {
+				t.Errorf("got login path %q, want %q", loginPath, tc.wantPath)
+			}
+			if payload["username"] != "admin" || payload["password"] != "secret" {
+				t.Errorf("got credentials %v", payload)
+			}
+			if _, remember := payload["remember"]; remember != tc.wantRemember {
+				t.Errorf("got remember %t, want %t", remember, tc.wantRemember)
+			}
+			if c.csrfToken != "token" {
+				t.Errorf("got CSRF token %q, want %q", c.csrfToken, "token")
+			}
+		})
+	}
+}
+
+func TestLoginSkippedWithAPIKey(t *testing.T) {
+	var logins atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+	}), TransportConfig{})
+
+	if n := logins.Load(); n != 0 {
+		t.Errorf("API key client logged in %d times", n)
+	}
+	if !c.IsStandalone {
+		t.Error("expected the standalone override to apply")
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem for HTTP traffic, enabled with
+// TF_LOG_PROVIDER_UNIFI_HTTP (e.g. DEBUG for requests, TRACE for bodies).
+const logSubsystem = "unifi_http"
+
+// sensitiveJSONField matches the values of secret fields in request and
+// response bodies. Hotspot voucher codes grant network access, so "code" is
+// masked too, even though other objects use it for harmless values.
+var sensitiveJSONField = regexp.MustCompile(`("(?:x_passphrase|x_secret|x_password|password|x_iapp_key|x_wireguard_private_key|x_api_key|code)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// sensitiveHeaders are masked when headers are logged.
+var sensitiveHeaders = map[string]bool{
+	"X-Api-Key":    true,
+	"X-Csrf-Token": true,
+	"Cookie":       true,
+	"Set-Cookie":   true,
+}
+
+func withLogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_UNIFI_HTTP"))
+}
+
+func redactBody(body []byte) string {
+	return sensitiveJSONField.ReplaceAllString(string(body), `$1"***"`)
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for k, v := range h {
+		if sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+			headers[k] = "***"
+			continue
+		}
+		headers[k] = strings.Join(v, ", ")
+	}
+	return headers
+}
+
+// logRetry is a retryablehttp.RequestLogHook that reports retry attempts
+// through the request's logging context.
+func logRetry(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if attempt == 0 {
+		return
+	}
+	tflog.SubsystemDebug(req.Context(), logSubsystem, "Retrying UniFi API request", map[string]any{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"attempt": attempt,
+	})
+}
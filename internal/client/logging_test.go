@@ -0,0 +1,71 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want string
+	}{
+		"password":      {body: `{"username":"admin","password":"hunter2"}`, want: `{"username":"admin","password":"***"}`},
+		"passphrase":    {body: `{"name":"Home","x_passphrase":"secret phrase"}`, want: `{"name":"Home","x_passphrase":"***"}`},
+		"x_password":    {body: `{"x_password": "guestpass"}`, want: `{"x_password": "***"}`},
+		"radius secret": {body: `{"auth_servers":[{"ip":"10.0.0.5","x_secret":"shared"}]}`, want: `{"auth_servers":[{"ip":"10.0.0.5","x_secret":"***"}]}`},
+		"api key":       {body: `{"x_api_key":"abc"}`, want: `{"x_api_key":"***"}`},
+		"wireguard key": {body: `{"x_wireguard_private_key":"k3y="}`, want: `{"x_wireguard_private_key":"***"}`},
+		"voucher codes": {body: `[{"code":"1234567890","note":"lobby"},{"code":"0987654321"}]`, want: `[{"code":"***","note":"lobby"},{"code":"***"}]`},
+		"escaped quote": {body: `{"password":"a\"b","name":"x"}`, want: `{"password":"***","name":"x"}`},
+		"non-sensitive": {body: `{"name":"Guest","vlan":20,"purpose":"guest"}`, want: `{"name":"Guest","vlan":20,"purpose":"guest"}`},
+		"similar key":   {body: `{"password_enabled":true,"passwords":"kept"}`, want: `{"password_enabled":true,"passwords":"kept"}`},
+		"empty":         {body: ``, want: ``},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-API-KEY", "key")
+	h.Set("X-Csrf-Token", "token")
+	h.Add("Cookie", "TOKEN=abc")
+	h.Add("Set-Cookie", "TOKEN=def")
+	h.Set("Content-Type", "application/json")
+	h.Add("Accept", "application/json")
+	h.Add("Accept", "text/plain")
+
+	want := map[string]string{
+		"X-Api-Key":    "***",
+		"X-Csrf-Token": "***",
+		"Cookie":       "***",
+		"Set-Cookie":   "***",
+		"Content-Type": "application/json",
+		"Accept":       "application/json, text/plain",
+	}
+
+	got := redactHeaders(h)
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("header %s: got %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestRedactHeadersNonCanonical(t *testing.T) {
+	// Headers set directly on the map keep their spelling but are still masked.
+	h := http.Header{"x-api-key": {"key"}}
+	if got := redactHeaders(h)["x-api-key"]; got != "***" {
+		t.Errorf("got %q, want the API key masked", got)
+	}
+}
@@ -378,6 +378,15 @@ type UserGroup struct {
 	AttrNoDelete   *bool  `json:"attr_no_delete,omitempty"`
 }
 
+// HotspotOperator represents a hotspot operator account used to manage vouchers.
+type HotspotOperator struct {
+	ID        string `json:"_id,omitempty"`
+	SiteID    string `json:"site_id,omitempty"`
+	Name      string `json:"name"`
+	XPassword string `json:"x_password,omitempty"`
+	Note      string `json:"note,omitempty"`
+}
+
 // RADIUSProfile represents a UniFi RADIUS profile.
 type RADIUSProfile struct {
 	ID                    string         `json:"_id,omitempty"`
@@ -484,3 +493,272 @@ type User struct {
 	FirstSeen   *int64 `json:"first_seen,omitempty"`
 	LastSeen    *int64 `json:"last_seen,omitempty"`
 }
+
+// ActiveClient represents a currently connected client (stat/sta).
+type ActiveClient struct {
+	ID        string `json:"_id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	MAC       string `json:"mac"`
+	Name      string `json:"name,omitempty"`
+	Hostname  string `json:"hostname,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	NetworkID string `json:"network_id,omitempty"`
+	ESSID     string `json:"essid,omitempty"`
+	Blocked   *bool  `json:"blocked,omitempty"`
+	IsWired   *bool  `json:"is_wired,omitempty"`
+	IsGuest   *bool  `json:"is_guest,omitempty"`
+	OUI       string `json:"oui,omitempty"`
+	FirstSeen *int64 `json:"first_seen,omitempty"`
+	LastSeen  *int64 `json:"last_seen,omitempty"`
+}
+
+// SettingIPS represents the site threat management (IDS/IPS) setting.
+type SettingIPS struct {
+	ID                       string                       `json:"_id,omitempty"`
+	SiteID                   string                       `json:"site_id,omitempty"`
+	Key                      string                       `json:"key"`
+	IPSMode                  string                       `json:"ips_mode,omitempty"`
+	EnabledCategories        []string                     `json:"enabled_categories"`
+	EnabledNetworks          []string                     `json:"enabled_networks"`
+	Suppression              *IPSSuppression              `json:"suppression,omitempty"`
+	AdBlockingEnabled        *bool                        `json:"ad_blocking_enabled,omitempty"`
+	AdBlockingConfigurations []IPSAdBlockingConfiguration `json:"ad_blocking_configurations"`
+}
+
+// IPSSuppression contains suppressed alerts and allowlisted traffic.
+type IPSSuppression struct {
+	Alerts    []IPSSuppressedAlert `json:"alerts"`
+	Whitelist []IPSWhitelistEntry  `json:"whitelist"`
+}
+
+// IPSSuppressedAlert silences a signature, either everywhere (type "all") or
+// only for the tracked addresses (type "track").
+type IPSSuppressedAlert struct {
+	ID        int64               `json:"id"`
+	GID       int64               `json:"gid"`
+	Category  string              `json:"category"`
+	Signature string              `json:"signature,omitempty"`
+	Type      string              `json:"type"`
+	Tracking  []IPSWhitelistEntry `json:"tracking"`
+}
+
+// IPSWhitelistEntry allowlists traffic from or to an address or network.
+type IPSWhitelistEntry struct {
+	Direction string `json:"direction"`
+	Mode      string `json:"mode"`
+	Value     string `json:"value"`
+}
+
+// IPSAdBlockingConfiguration enables ad blocking on a network.
+type IPSAdBlockingConfiguration struct {
+	NetworkID string `json:"network_id"`
+}
+
+// SettingDPI represents the site deep packet inspection setting.
+type SettingDPI struct {
+	ID                    string `json:"_id,omitempty"`
+	SiteID                string `json:"site_id,omitempty"`
+	Key                   string `json:"key"`
+	Enabled               *bool  `json:"enabled,omitempty"`
+	FingerprintingEnabled *bool  `json:"fingerprintingEnabled,omitempty"`
+}
+
+// ContentFilter represents a DNS content filtering profile (v2 API).
+type ContentFilter struct {
+	ID         string   `json:"_id,omitempty"`
+	Name       string   `json:"name"`
+	Enabled    *bool    `json:"enabled,omitempty"`
+	NetworkIDs []string `json:"network_ids"`
+	ClientMACs []string `json:"client_macs"`
+	Categories []string `json:"categories"`
+	SafeSearch []string `json:"safe_search"`
+	AllowList  []string `json:"allow_list"`
+	BlockList  []string `json:"block_list"`
+}
+
+// SettingGuestAccess represents the site guest portal (hotspot) setting.
+// The optional strings are always sent, so that they can be cleared; callers
+// start from the current setting to leave them unchanged.
+type SettingGuestAccess struct {
+	ID                         string `json:"_id,omitempty"`
+	SiteID                     string `json:"site_id,omitempty"`
+	Key                        string `json:"key"`
+	Auth                       string `json:"auth,omitempty"`
+	PortalEnabled              *bool  `json:"portal_enabled,omitempty"`
+	Expire                     *int   `json:"expire,omitempty"`
+	RedirectEnabled            *bool  `json:"redirect_enabled,omitempty"`
+	RedirectURL                string `json:"redirect_url"`
+	PortalCustomizedTitle      string `json:"portal_customized_title,omitempty"`
+	PortalCustomizedTOSEnabled *bool  `json:"portal_customized_tos_enabled,omitempty"`
+	PortalCustomizedTOS        string `json:"portal_customized_tos"`
+	PasswordEnabled            *bool  `json:"password_enabled,omitempty"`
+	XPassword                  string `json:"x_password"`
+	VoucherEnabled             *bool  `json:"voucher_enabled,omitempty"`
+	PaymentEnabled             *bool  `json:"payment_enabled,omitempty"`
+	Gateway                    string `json:"gateway,omitempty"`
+	AllowedSubnet1             string `json:"allowed_subnet_1"`
+	AllowedSubnet2             string `json:"allowed_subnet_2"`
+	AllowedSubnet3             string `json:"allowed_subnet_3"`
+}
+
+// VoucherBatch describes a batch of hotspot vouchers to create.
+type VoucherBatch struct {
+	Count    int
+	Duration int
+	Quota    int
+	Note     string
+	Up       *int
+	Down     *int
+	Bytes    *int
+}
+
+// Voucher represents a hotspot voucher.
+type Voucher struct {
+	ID             string `json:"_id,omitempty"`
+	SiteID         string `json:"site_id,omitempty"`
+	Code           string `json:"code"`
+	CreateTime     int64  `json:"create_time"`
+	Duration       *int   `json:"duration,omitempty"`
+	Quota          *int   `json:"quota,omitempty"`
+	Used           *int   `json:"used,omitempty"`
+	Note           string `json:"note,omitempty"`
+	QosRateMaxUp   *int   `json:"qos_rate_max_up,omitempty"`
+	QosRateMaxDown *int   `json:"qos_rate_max_down,omitempty"`
+	QosUsageQuota  *int   `json:"qos_usage_quota,omitempty"`
+	Status         string `json:"status,omitempty"`
+}
+
+// Admin represents a controller administrator and their role on a site.
+type Admin struct {
+	ID          string   `json:"_id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Role        string   `json:"role,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	IsSuper     *bool    `json:"is_super,omitempty"`
+}
+
+func (a *Admin) permissionsOrEmpty() []string {
+	if a.Permissions == nil {
+		return []string{}
+	}
+	return a.Permissions
+}
+
+// Device represents an adopted UniFi device (stat/device).
+type Device struct {
+	ID         string        `json:"_id,omitempty"`
+	SiteID     string        `json:"site_id,omitempty"`
+	MAC        string        `json:"mac"`
+	Name       string        `json:"name,omitempty"`
+	Model      string        `json:"model,omitempty"`
+	Type       string        `json:"type,omitempty"`
+	Version    string        `json:"version,omitempty"`
+	IP         string        `json:"ip,omitempty"`
+	Adopted    *bool         `json:"adopted,omitempty"`
+	State      int           `json:"state"`
+	Uplink     *DeviceUplink `json:"uplink,omitempty"`
+	PortTable  []DevicePort  `json:"port_table,omitempty"`
+	RadioTable []DeviceRadio `json:"radio_table,omitempty"`
+}
+
+// DeviceUplink describes the upstream connection of a device.
+type DeviceUplink struct {
+	Type             string `json:"type,omitempty"`
+	UplinkMAC        string `json:"uplink_mac,omitempty"`
+	UplinkRemotePort *int   `json:"uplink_remote_port,omitempty"`
+}
+
+// DevicePort describes a switch port of a device.
+type DevicePort struct {
+	PortIdx    int    `json:"port_idx"`
+	Name       string `json:"name,omitempty"`
+	Media      string `json:"media,omitempty"`
+	Up         *bool  `json:"up,omitempty"`
+	Speed      *int   `json:"speed,omitempty"`
+	PoeMode    string `json:"poe_mode,omitempty"`
+	PortconfID string `json:"portconf_id,omitempty"`
+}
+
+// DeviceRadio describes a radio of an access point.
+type DeviceRadio struct {
+	Name        string     `json:"name,omitempty"`
+	Radio       string     `json:"radio,omitempty"`
+	Channel     FlexString `json:"channel,omitempty"`
+	Ht          FlexString `json:"ht,omitempty"`
+	TxPowerMode string     `json:"tx_power_mode,omitempty"`
+	TxPower     FlexString `json:"tx_power,omitempty"`
+}
+
+// FlexString is a string field the controller may also encode as a number
+// (e.g. a radio channel that is either "auto" or 36).
+type FlexString string
+
+func (f *FlexString) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*f = FlexString(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*f = FlexString(n.String())
+	return nil
+}
+
+// SysInfo describes the Network application and, on UniFi OS consoles, the
+// console it runs on.
+type SysInfo struct {
+	Version               string `json:"version,omitempty"`
+	Build                 string `json:"build,omitempty"`
+	Hostname              string `json:"hostname,omitempty"`
+	Name                  string `json:"name,omitempty"`
+	Timezone              string `json:"timezone,omitempty"`
+	DeviceType            string `json:"ubnt_device_type,omitempty"`
+	UDMVersion            string `json:"udm_version,omitempty"`
+	ConsoleDisplayVersion string `json:"console_display_version,omitempty"`
+	UpdateAvailable       *bool  `json:"update_available,omitempty"`
+
+	// Populated from /api/system on UniFi OS consoles.
+	IsUniFiOS       bool   `json:"-"`
+	ConsoleName     string `json:"-"`
+	ConsoleHardware string `json:"-"`
+}
+
+// AtLeast reports whether the Network application version is at least min
+// (e.g. "8.1"). Unparseable versions compare as 0.
+func (s *SysInfo) AtLeast(min string) bool {
+	return compareVersions(s.Version, min) >= 0
+}
+
+// consoleSystem is the UniFi OS /api/system response.
+type consoleSystem struct {
+	Name     string `json:"name"`
+	MAC      string `json:"mac"`
+	Hardware struct {
+		ShortName string `json:"shortname"`
+	} `json:"hardware"`
+}
+
+// DPIApp is an entry of the DPI application catalogue.
+type DPIApp struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	CategoryID int    `json:"cat"`
+}
+
+// DPICategory is an entry of the DPI category catalogue.
+type DPICategory struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Region is a country supported by the controller. Key is the ISO 3166-1
+// alpha-2 code used for geo-blocking; Code is the numeric ISO code.
+type Region struct {
+	Code string `json:"code"`
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeviceStateProvisioning is the stat/device state of a device that is
+// applying configuration pushed by the controller.
+const DeviceStateProvisioning = 5
+
+// The controller starts reprovisioning a few seconds after a change, so a
+// wait only ends once several consecutive polls find nothing provisioning.
+// The interval is a variable so tests can poll quickly.
+var provisionPollInterval = 3 * time.Second
+
+const provisionSettlePolls = 3
+
+// WaitForProvision polls stat/device until no device of the given types
+// (all devices when none are given) is provisioning. It returns a
+// *ProvisionError naming the devices still provisioning if ctx ends first,
+// and nil if the last poll before then found nothing provisioning.
+func (c *Client) WaitForProvision(ctx context.Context, deviceTypes ...string) error {
+	var pending []Device
+	polled := false
+	settled := 0
+	for {
+		devices, err := c.ListDevices(ctx)
+		if err != nil {
+			if ctx.Err() != nil && polled {
+				return provisionResult(pending)
+			}
+			return err
+		}
+		polled = true
+
+		pending = pending[:0]
+		for _, d := range devices {
+			if d.State == DeviceStateProvisioning && (len(deviceTypes) == 0 || slices.Contains(deviceTypes, d.Type)) {
+				pending = append(pending, d)
+			}
+		}
+		if len(pending) == 0 {
+			settled++
+			if settled >= provisionSettlePolls {
+				return nil
+			}
+		} else {
+			settled = 0
+			tflog.Debug(ctx, "Waiting for UniFi devices to finish provisioning", map[string]any{
+				"devices": len(pending),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return provisionResult(pending)
+		case <-time.After(provisionPollInterval):
+		}
+	}
+}
+
+// provisionResult is the outcome of a wait that ran out of time: devices
+// that were provisioning at the last poll did not converge, and an empty
+// last poll means they all had.
+func provisionResult(pending []Device) error {
+	if len(pending) > 0 {
+		return &ProvisionError{Devices: pending}
+	}
+	return nil
+}
+
+// ProvisionError reports the devices that were still provisioning when
+// WaitForProvision gave up.
+type ProvisionError struct {
+	Devices []Device
+}
+
+func (e *ProvisionError) Error() string {
+	names := make([]string, len(e.Devices))
+	for i, d := range e.Devices {
+		names[i] = d.MAC
+		if d.Name != "" {
+			names[i] = fmt.Sprintf("%s (%s)", d.Name, d.MAC)
+		}
+	}
+	return "devices still provisioning: " + strings.Join(names, ", ")
+}
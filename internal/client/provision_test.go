@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForProvision(t *testing.T) {
+	provisioning := Device{MAC: "aa:bb:cc:dd:ee:01", Name: "Office AP", Type: "uap", State: DeviceStateProvisioning}
+	idleAP := Device{MAC: "aa:bb:cc:dd:ee:01", Name: "Office AP", Type: "uap", State: 1}
+	provisioningSwitch := Device{MAC: "aa:bb:cc:dd:ee:02", Type: "usw", State: DeviceStateProvisioning}
+
+	tests := map[string]struct {
+		polls       [][]Device // stat/device responses; the last one repeats
+		deviceTypes []string
+		timeout     time.Duration
+		wantErr     string
+	}{
+		"converged": {
+			polls:   [][]Device{{provisioning}, {provisioning}, {idleAP}},
+			timeout: time.Second,
+		},
+		"not converged": {
+			polls:   [][]Device{{provisioning}},
+			timeout: 50 * time.Millisecond,
+			wantErr: "devices still provisioning: Office AP (aa:bb:cc:dd:ee:01)",
+		},
+		"idle at timeout": {
+			// The wait times out before enough empty polls to settle, but
+			// nothing was provisioning at the last one.
+			polls:   [][]Device{{provisioning}, {idleAP}},
+			timeout: 25 * time.Millisecond,
+		},
+		"other device types": {
+			polls:       [][]Device{{idleAP, provisioningSwitch}},
+			deviceTypes: []string{"uap"},
+			timeout:     time.Second,
+		},
+		"all device types": {
+			polls:   [][]Device{{idleAP, provisioningSwitch}},
+			timeout: 50 * time.Millisecond,
+			wantErr: "devices still provisioning: aa:bb:cc:dd:ee:02",
+		},
+	}
+
+	interval := provisionPollInterval
+	provisionPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { provisionPollInterval = interval })
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/s/default/stat/device" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				n := int(calls.Add(1)) - 1
+				writeData(w, tc.polls[min(n, len(tc.polls)-1)])
+			}), TransportConfig{})
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+			err := c.WaitForProvision(ctx, tc.deviceTypes...)
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			var provisionErr *ProvisionError
+			if !errors.As(err, &provisionErr) {
+				t.Fatalf("got %v, want a *ProvisionError", err)
+			}
+			if err.Error() != tc.wantErr {
+				t.Errorf("got %q, want %q", err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
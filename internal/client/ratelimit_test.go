@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		"empty":       {value: ""},
+		"seconds":     {value: "5", want: 5 * time.Second, wantOK: true},
+		"zero":        {value: "0", wantOK: true},
+		"negative":    {value: "-1"},
+		"past date":   {value: "Mon, 01 Jan 2001 00:00:00 GMT", wantOK: true},
+		"not a delay": {value: "soon"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.value)
+			if ok != tc.wantOK || got != tc.want {
+				t.Errorf("got (%s, %t), want (%s, %t)", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		at := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+		got, ok := parseRetryAfter(at)
+		if !ok || got <= 50*time.Second || got > time.Minute {
+			t.Errorf("got (%s, %t), want about a minute", got, ok)
+		}
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	const minWait, maxWait = time.Second, 30 * time.Second
+
+	tests := map[string]struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		"retry-after":        {retryAfter: "2", want: 2 * time.Second},
+		"retry-after capped": {retryAfter: "3600", want: maxWait},
+		"no header":          {want: 4 * time.Second},
+		"invalid header":     {retryAfter: "soon", want: 4 * time.Second},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+			if tc.retryAfter != "" {
+				resp.Header.Set("Retry-After", tc.retryAfter)
+			}
+			if got := backoff(minWait, maxWait, 2, resp); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckRetry(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset")}
+
+	tests := map[string]struct {
+		nonIdempotent bool
+		status        int
+		err           error
+		want          bool
+	}{
+		"ok":                      {status: http.StatusOK},
+		"not found":               {status: http.StatusNotFound},
+		"unavailable":             {status: http.StatusServiceUnavailable, want: true},
+		"too many requests":       {status: http.StatusTooManyRequests, want: true},
+		"server error":            {status: http.StatusInternalServerError, want: true},
+		"connection reset":        {err: readErr, want: true},
+		"post ok":                 {nonIdempotent: true, status: http.StatusOK},
+		"post unavailable":        {nonIdempotent: true, status: http.StatusServiceUnavailable},
+		"post server error":       {nonIdempotent: true, status: http.StatusInternalServerError},
+		"post too many requests":  {nonIdempotent: true, status: http.StatusTooManyRequests, want: true},
+		"post connection refused": {nonIdempotent: true, err: dialErr, want: true},
+		"post connection reset":   {nonIdempotent: true, err: readErr},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.nonIdempotent {
+				ctx = withNonIdempotent(ctx)
+			}
+			var resp *http.Response
+			if tc.err == nil {
+				resp = &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			}
+			got, err := checkRetry(ctx, resp, tc.err)
+			if got != tc.want {
+				t.Errorf("got retry %t, want %t (err %v)", got, tc.want, err)
+			}
+		})
+	}
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		retry, err := checkRetry(ctx, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil)
+		if retry || !errors.Is(err, context.Canceled) {
+			t.Errorf("got (%t, %v), want no retry and the context error", retry, err)
+		}
+	})
+}
+
+// failingHandler answers the first failures requests with status and a
+// Retry-After header, then succeeds. It counts all requests in attempts.
+func failingHandler(failures int, status int, retryAfter string, attempts *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(attempts.Add(1)) <= failures {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(status)
+			return
+		}
+		writeData(w, []any{})
+	})
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	retryMax := 3
+	transport := TransportConfig{RetryMax: &retryMax, RetryWaitMin: time.Millisecond, RetryWaitMax: 10 * time.Millisecond}
+
+	tests := map[string]struct {
+		method       string
+		status       int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		"get unavailable":        {method: "GET", status: http.StatusServiceUnavailable, wantAttempts: 3},
+		"get too many requests":  {method: "GET", status: http.StatusTooManyRequests, wantAttempts: 3},
+		"put unavailable":        {method: "PUT", status: http.StatusServiceUnavailable, wantAttempts: 3},
+		"post unavailable":       {method: "POST", status: http.StatusServiceUnavailable, wantAttempts: 1, wantErr: true},
+		"post too many requests": {method: "POST", status: http.StatusTooManyRequests, wantAttempts: 3},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			// Retry-After asks for an hour; retry_wait_max must cap it.
+			c := newTestClient(t, failingHandler(2, tc.status, "3600", &attempts), transport)
+
+			start := time.Now()
+			var result []any
+			err := c.doRequest(context.Background(), tc.method, "/api/s/default/rest/test", nil, &result)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %t", err, tc.wantErr)
+			}
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tc.wantAttempts)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("took %s; Retry-After was not capped", elapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimitAppliesToRetries(t *testing.T) {
+	var attempts atomic.Int32
+	var mu sync.Mutex
+	var times []time.Time
+	failing := failingHandler(2, http.StatusServiceUnavailable, "0", &attempts)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		failing.ServeHTTP(w, r)
+	})
+	c := newTestClient(t, handler, TransportConfig{
+		RequestsPerSecond: 10,
+		RetryWaitMin:      time.Millisecond,
+		RetryWaitMax:      time.Millisecond,
+	})
+
+	var result []any
+	if err := c.doRequest(context.Background(), "GET", "/api/s/default/rest/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(times) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(times))
+	}
+	for i := 1; i < len(times); i++ {
+		if gap := times[i].Sub(times[i-1]); gap < 90*time.Millisecond {
+			t.Errorf("attempt %d followed the previous one after %s, want the 100ms rate limit", i+1, gap)
+		}
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	tests := map[string]struct {
+		maxConcurrent int
+		want          int32
+	}{
+		"unlimited by default": {want: 6},
+		"capped":               {maxConcurrent: 2, want: 2},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			const requests = 6
+			var inFlight, peak atomic.Int32
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := inFlight.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(50 * time.Millisecond)
+				inFlight.Add(-1)
+				writeData(w, []any{})
+			})
+			c := newTestClient(t, handler, TransportConfig{MaxConcurrentRequests: tc.maxConcurrent})
+
+			var wg sync.WaitGroup
+			for i := range requests {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					var result []any
+					if err := c.doRequest(context.Background(), "GET", "/api/s/default/rest/test"+strconv.Itoa(i), nil, &result); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := peak.Load(); got != tc.want {
+				t.Errorf("got %d requests in flight at once, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// selfSignedPEM returns a new self-signed certificate and its key, PEM encoded.
+func selfSignedPEM(t *testing.T, name string) (certPEM, keyPEM string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestTransportServerVerification(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	sum := sha256.Sum256(srv.Certificate().Raw)
+	pin := hex.EncodeToString(sum[:])
+	colonPin := strings.ToUpper(strings.Join(splitPairs(pin), ":"))
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	otherCA, _ := selfSignedPEM(t, "other")
+
+	tests := map[string]struct {
+		cfg     TransportConfig
+		wantErr string
+	}{
+		"untrusted":          {cfg: TransportConfig{}, wantErr: "certificate"},
+		"insecure":           {cfg: TransportConfig{Insecure: true}},
+		"trusted ca":         {cfg: TransportConfig{CACertPEM: serverCA}},
+		"wrong ca":           {cfg: TransportConfig{CACertPEM: otherCA}, wantErr: "certificate"},
+		"matching pin":       {cfg: TransportConfig{CertFingerprint: pin}},
+		"matching colon pin": {cfg: TransportConfig{CertFingerprint: colonPin}},
+		"mismatched pin":     {cfg: TransportConfig{CertFingerprint: strings.Repeat("00", sha256.Size)}, wantErr: "does not match the pinned fingerprint"},
+		"pin and ca":         {cfg: TransportConfig{CertFingerprint: pin, CACertPEM: serverCA}},
+		"pin and wrong ca":   {cfg: TransportConfig{CertFingerprint: pin, CACertPEM: otherCA}, wantErr: "configured CA"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tr, err := newTransport(tc.cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func splitPairs(s string) []string {
+	var pairs []string
+	for i := 0; i < len(s); i += 2 {
+		pairs = append(pairs, s[i:i+2])
+	}
+	return pairs
+}
+
+func TestTransportConfigErrors(t *testing.T) {
+	certPEM, keyPEM := selfSignedPEM(t, "client")
+	_, otherKeyPEM := selfSignedPEM(t, "other")
+
+	tests := map[string]struct {
+		cfg     TransportConfig
+		wantErr string
+	}{
+		"bad ca pem":         {cfg: TransportConfig{CACertPEM: "not a certificate"}, wantErr: "no certificates found"},
+		"short pin":          {cfg: TransportConfig{CertFingerprint: "abcd"}, wantErr: "SHA-256 digest"},
+		"non-hex pin":        {cfg: TransportConfig{CertFingerprint: strings.Repeat("zz", sha256.Size)}, wantErr: "SHA-256 digest"},
+		"client cert no key": {cfg: TransportConfig{ClientCertPEM: certPEM}, wantErr: "client certificate"},
+		"mismatched key":     {cfg: TransportConfig{ClientCertPEM: certPEM, ClientKeyPEM: otherKeyPEM}, wantErr: "client certificate"},
+		"bad proxy":          {cfg: TransportConfig{ProxyURL: "http://[::1"}, wantErr: "proxy URL"},
+		"valid client cert":  {cfg: TransportConfig{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTransport(tc.cfg)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	certPEM, keyPEM := selfSignedPEM(t, "client")
+	block, _ := pem.Decode([]byte(certPEM))
+	clientCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("parsing certificate: %s", err)
+	}
+	clients := x509.NewCertPool()
+	clients.AddCert(clientCert)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clients}
+	srv.StartTLS()
+	defer srv.Close()
+
+	tests := map[string]struct {
+		cfg     TransportConfig
+		wantErr bool
+	}{
+		"with client certificate":    {cfg: TransportConfig{Insecure: true, ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}},
+		"without client certificate": {cfg: TransportConfig{Insecure: true}, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tr, err := newTransport(tc.cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+			if tc.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("expected the server to reject the connection")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	tr, err := newTransport(TransportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := (&http.Client{Transport: tr}).Get("http://controller.invalid/status")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if proxied != "http://controller.invalid/status" {
+		t.Errorf("got proxied request %q, want it sent through the proxy", proxied)
+	}
+}
@@ -46,6 +46,14 @@ func Int64Value(v *int) types.Int64 {
 	return types.Int64Value(int64(*v))
 }
 
+// Int64PtrValue returns a types.Int64 from an int64 pointer.
+func Int64PtrValue(v *int64) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*v)
+}
+
 // BoolPtr returns a pointer to the bool value if it's not null or unknown.
 func BoolPtr(v types.Bool) *bool {
 	if v.IsNull() || v.IsUnknown() {
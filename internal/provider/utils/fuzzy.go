@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// NormalizeName lowercases s and drops everything but letters and digits, so
+// that "Amazon Prime Video" and "amazon-primevideo" compare equal.
+func NormalizeName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// FuzzyFind returns the index of the candidate matching query. An exact
+// normalized match wins; otherwise a single candidate containing the query is
+// accepted. When there is no unambiguous match it returns -1 together with up
+// to limit close candidates, nearest first.
+func FuzzyFind(query string, candidates []string, limit int) (int, []string) {
+	q := NormalizeName(query)
+
+	var containing []int
+	for i, c := range candidates {
+		n := NormalizeName(c)
+		if n == q {
+			return i, nil
+		}
+		if q != "" && strings.Contains(n, q) {
+			containing = append(containing, i)
+		}
+	}
+	if len(containing) == 1 {
+		return containing[0], nil
+	}
+
+	type scored struct {
+		name     string
+		distance int
+	}
+	scores := make([]scored, 0, len(candidates))
+	for _, c := range candidates {
+		n := NormalizeName(c)
+		d := levenshtein(q, n)
+		if strings.Contains(n, q) {
+			d = 0
+		}
+		scores = append(scores, scored{name: c, distance: d})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].distance < scores[j].distance
+	})
+
+	var nearest []string
+	for _, s := range scores {
+		if len(nearest) == limit {
+			break
+		}
+		nearest = append(nearest, s.name)
+	}
+	return -1, nearest
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
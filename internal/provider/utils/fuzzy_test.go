@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		want string
+	}{
+		"spaces":      {name: "Amazon Prime Video", want: "amazonprimevideo"},
+		"punctuation": {name: "amazon-primevideo", want: "amazonprimevideo"},
+		"digits":      {name: "Channel 4", want: "channel4"},
+		"empty":       {name: "", want: ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := NormalizeName(tc.name); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFuzzyFind(t *testing.T) {
+	candidates := []string{"Netflix", "Amazon Prime Video", "YouTube", "YouTube Music"}
+
+	tests := map[string]struct {
+		query       string
+		limit       int
+		wantIndex   int
+		wantNearest []string
+	}{
+		"exact":                {query: "Netflix", wantIndex: 0},
+		"normalized":           {query: "amazon-prime-video", wantIndex: 1},
+		"exact beats contains": {query: "youtube", wantIndex: 2},
+		"single contains":      {query: "prime", wantIndex: 1},
+		"ambiguous contains":   {query: "tube", limit: 2, wantIndex: -1, wantNearest: []string{"YouTube", "YouTube Music"}},
+		"typo":                 {query: "Netflex", limit: 1, wantIndex: -1, wantNearest: []string{"Netflix"}},
+		"no limit":             {query: "Netflex", wantIndex: -1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			index, nearest := FuzzyFind(tc.query, candidates, tc.limit)
+			if index != tc.wantIndex {
+				t.Errorf("got index %d, want %d", index, tc.wantIndex)
+			}
+			if !slices.Equal(nearest, tc.wantNearest) {
+				t.Errorf("got nearest %q, want %q", nearest, tc.wantNearest)
+			}
+		})
+	}
+}
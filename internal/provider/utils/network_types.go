@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The types below are string attributes whose values the controller may
+// rewrite in an equivalent form: MACs are lowercased, addresses and networks
+// are reformatted. Semantic equality keeps the configured form in state so
+// these rewrites do not show up as diffs.
+
+var (
+	_ basetypes.StringTypable                    = MACAddressType{}
+	_ basetypes.StringValuableWithSemanticEquals = MACAddressValue{}
+	_ basetypes.StringTypable                    = CIDRType{}
+	_ basetypes.StringValuableWithSemanticEquals = CIDRValue{}
+	_ basetypes.StringTypable                    = IPAddressType{}
+	_ basetypes.StringValuableWithSemanticEquals = IPAddressValue{}
+)
+
+// MACAddressType is a string type for MAC addresses that compares them
+// ignoring case and separator style.
+type MACAddressType struct {
+	basetypes.StringType
+}
+
+func (t MACAddressType) String() string {
+	return "utils.MACAddressType"
+}
+
+func (t MACAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(MACAddressType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t MACAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MACAddressValue{StringValue: in}, nil
+}
+
+func (t MACAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := stringFromTerraform(ctx, t.StringType, in)
+	return MACAddressValue{StringValue: v}, err
+}
+
+func (t MACAddressType) ValueType(_ context.Context) attr.Value {
+	return MACAddressValue{}
+}
+
+// MACAddressValue is a value of MACAddressType.
+type MACAddressValue struct {
+	basetypes.StringValue
+}
+
+func (v MACAddressValue) Type(_ context.Context) attr.Type {
+	return MACAddressType{}
+}
+
+func (v MACAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(MACAddressValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v MACAddressValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(MACAddressValue)
+	if !ok {
+		return false, semanticEqualsTypeError(v, newValuable)
+	}
+	return NormalizeMAC(v.ValueString()) == NormalizeMAC(newValue.ValueString()), nil
+}
+
+// NewMACAddressValue returns a known MACAddressValue.
+func NewMACAddressValue(s string) MACAddressValue {
+	return MACAddressValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// NormalizeMAC returns mac in the lowercase, colon-separated form the
+// controller uses.
+func NormalizeMAC(mac string) string {
+	return strings.ReplaceAll(strings.ToLower(mac), "-", ":")
+}
+
+// CIDRType is a string type for networks in CIDR notation, including the
+// gateway-host form used by network subnets. Plain addresses are accepted as
+// well, and values that are neither compare as plain strings, so it also
+// suits attributes that mix addresses with other values.
+type CIDRType struct {
+	basetypes.StringType
+}
+
+func (t CIDRType) String() string {
+	return "utils.CIDRType"
+}
+
+func (t CIDRType) Equal(o attr.Type) bool {
+	other, ok := o.(CIDRType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t CIDRType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CIDRValue{StringValue: in}, nil
+}
+
+func (t CIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := stringFromTerraform(ctx, t.StringType, in)
+	return CIDRValue{StringValue: v}, err
+}
+
+func (t CIDRType) ValueType(_ context.Context) attr.Value {
+	return CIDRValue{}
+}
+
+// CIDRValue is a value of CIDRType.
+type CIDRValue struct {
+	basetypes.StringValue
+}
+
+func (v CIDRValue) Type(_ context.Context) attr.Type {
+	return CIDRType{}
+}
+
+func (v CIDRValue) Equal(o attr.Value) bool {
+	other, ok := o.(CIDRValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v CIDRValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(CIDRValue)
+	if !ok {
+		return false, semanticEqualsTypeError(v, newValuable)
+	}
+	return normalizeCIDR(v.ValueString()) == normalizeCIDR(newValue.ValueString()), nil
+}
+
+// NewCIDRValue returns a known CIDRValue.
+func NewCIDRValue(s string) CIDRValue {
+	return CIDRValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func normalizeCIDR(s string) string {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.String()
+	}
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return addr.String()
+	}
+	return s
+}
+
+// IPAddressType is a string type for IPv4 and IPv6 addresses that compares
+// them by value, so IPv6 case and zero compression do not matter.
+type IPAddressType struct {
+	basetypes.StringType
+}
+
+func (t IPAddressType) String() string {
+	return "utils.IPAddressType"
+}
+
+func (t IPAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t IPAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddressValue{StringValue: in}, nil
+}
+
+func (t IPAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := stringFromTerraform(ctx, t.StringType, in)
+	return IPAddressValue{StringValue: v}, err
+}
+
+func (t IPAddressType) ValueType(_ context.Context) attr.Value {
+	return IPAddressValue{}
+}
+
+// IPAddressValue is a value of IPAddressType.
+type IPAddressValue struct {
+	basetypes.StringValue
+}
+
+func (v IPAddressValue) Type(_ context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+func (v IPAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddressValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v IPAddressValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(IPAddressValue)
+	if !ok {
+		return false, semanticEqualsTypeError(v, newValuable)
+	}
+	a, errA := netip.ParseAddr(v.ValueString())
+	b, errB := netip.ParseAddr(newValue.ValueString())
+	if errA != nil || errB != nil {
+		return v.ValueString() == newValue.ValueString(), nil
+	}
+	return a == b, nil
+}
+
+// NewIPAddressValue returns a known IPAddressValue.
+func NewIPAddressValue(s string) IPAddressValue {
+	return IPAddressValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func stringFromTerraform(ctx context.Context, t basetypes.StringType, in tftypes.Value) (basetypes.StringValue, error) {
+	attrValue, err := t.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return basetypes.StringValue{}, err
+	}
+	v, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return basetypes.StringValue{}, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return v, nil
+}
+
+func semanticEqualsTypeError(v attr.Value, newValuable basetypes.StringValuable) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"Semantic Equality Check Error",
+		fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+	)
+	return diags
+}
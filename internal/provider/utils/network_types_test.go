@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior basetypes.StringValuableWithSemanticEquals
+		new   basetypes.StringValuable
+		want  bool
+	}{
+		"mac case":              {prior: NewMACAddressValue("AA:BB:CC:DD:EE:FF"), new: NewMACAddressValue("aa:bb:cc:dd:ee:ff"), want: true},
+		"mac separators":        {prior: NewMACAddressValue("aa-bb-cc-dd-ee-ff"), new: NewMACAddressValue("aa:bb:cc:dd:ee:ff"), want: true},
+		"mac different":         {prior: NewMACAddressValue("aa:bb:cc:dd:ee:ff"), new: NewMACAddressValue("aa:bb:cc:dd:ee:00")},
+		"cidr ipv6 compression": {prior: NewCIDRValue("fd00:0:0::/64"), new: NewCIDRValue("fd00::/64"), want: true},
+		"cidr different host":   {prior: NewCIDRValue("192.168.1.1/24"), new: NewCIDRValue("192.168.1.0/24")},
+		"cidr plain address":    {prior: NewCIDRValue("FD00::1"), new: NewCIDRValue("fd00::1"), want: true},
+		"cidr other strings":    {prior: NewCIDRValue("any"), new: NewCIDRValue("any"), want: true},
+		"ip ipv6 case":          {prior: NewIPAddressValue("FD00::A"), new: NewIPAddressValue("fd00::a"), want: true},
+		"ip ipv6 zeros":         {prior: NewIPAddressValue("fd00:0:0:0:0:0:0:1"), new: NewIPAddressValue("fd00::1"), want: true},
+		"ip different":          {prior: NewIPAddressValue("10.0.0.1"), new: NewIPAddressValue("10.0.0.2")},
+		"ip unparsable":         {prior: NewIPAddressValue("auto"), new: NewIPAddressValue("auto"), want: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := tc.prior.StringSemanticEquals(context.Background(), tc.new)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringSemanticEqualsTypeMismatch(t *testing.T) {
+	_, diags := NewMACAddressValue("aa:bb:cc:dd:ee:ff").StringSemanticEquals(context.Background(), NewCIDRValue("10.0.0.0/8"))
+	if !diags.HasError() {
+		t.Fatal("expected an error comparing values of different types")
+	}
+}
@@ -0,0 +1,208 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = stringCheckValidator{}
+
+// stringCheckValidator reports a string attribute for which check fails.
+type stringCheckValidator struct {
+	description string
+	summary     string
+	check       func(string) error
+}
+
+func (v stringCheckValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v stringCheckValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringCheckValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, err.Error()+".")
+	}
+}
+
+// IPAddress validates an IPv4 or IPv6 address.
+func IPAddress() validator.String {
+	return stringCheckValidator{
+		description: "value must be an IP address",
+		summary:     "Invalid IP Address",
+		check:       CheckIPAddress,
+	}
+}
+
+// CIDR validates a network in CIDR notation, e.g. 10.0.0.0/8.
+func CIDR() validator.String {
+	return stringCheckValidator{
+		description: "value must be a network in CIDR notation",
+		summary:     "Invalid CIDR",
+		check:       CheckCIDR,
+	}
+}
+
+// IPOrCIDR validates an IP address or a network in CIDR notation.
+func IPOrCIDR() validator.String {
+	return stringCheckValidator{
+		description: "value must be an IP address or a network in CIDR notation",
+		summary:     "Invalid IP Address or CIDR",
+		check:       CheckIPOrCIDR,
+	}
+}
+
+// GatewayCIDR validates the gateway-host form UniFi uses for network
+// subnets: the gateway's IPv4 address and the prefix length, e.g.
+// 192.168.1.1/24.
+func GatewayCIDR() validator.String {
+	return stringCheckValidator{
+		description: "value must be an IPv4 gateway address with a prefix length, e.g. 192.168.1.1/24",
+		summary:     "Invalid Subnet",
+		check:       CheckGatewayCIDR,
+	}
+}
+
+// MACAddress validates a MAC address written as six colon- or
+// hyphen-separated hex octets.
+func MACAddress() validator.String {
+	return stringCheckValidator{
+		description: "value must be a MAC address, e.g. aa:bb:cc:dd:ee:ff",
+		summary:     "Invalid MAC Address",
+		check:       CheckMACAddress,
+	}
+}
+
+// The Check functions back the validators above. They are exported for
+// checks that depend on other attributes, such as a resource's ValidateConfig.
+
+func CheckIPAddress(s string) error {
+	if _, err := netip.ParseAddr(s); err != nil {
+		return fmt.Errorf("%q is not a valid IP address", s)
+	}
+	return nil
+}
+
+func CheckCIDR(s string) error {
+	if _, err := netip.ParsePrefix(s); err != nil {
+		return fmt.Errorf("%q is not a valid network in CIDR notation (e.g. 10.0.0.0/8)", s)
+	}
+	return nil
+}
+
+func CheckIPOrCIDR(s string) error {
+	if _, err := netip.ParseAddr(s); err == nil {
+		return nil
+	}
+	if _, err := netip.ParsePrefix(s); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid IP address or network in CIDR notation", s)
+}
+
+func CheckGatewayCIDR(s string) error {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil || !prefix.Addr().Is4() {
+		return fmt.Errorf("%q is not an IPv4 gateway address with a prefix length (e.g. 192.168.1.1/24)", s)
+	}
+	if prefix.Bits() > 30 {
+		return fmt.Errorf("%q leaves no room for clients; use a prefix length of at most /30", s)
+	}
+	network := prefix.Masked().Addr()
+	if prefix.Addr() == network {
+		return fmt.Errorf("%q is the network address; UniFi expects the gateway address, e.g. %s/%d", s, network.Next(), prefix.Bits())
+	}
+	if prefix.Addr() == broadcast(prefix) {
+		return fmt.Errorf("%q is the broadcast address; UniFi expects the gateway address, e.g. %s/%d", s, network.Next(), prefix.Bits())
+	}
+	return nil
+}
+
+var macPattern = regexp.MustCompile(`^(?:[0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$|^(?:[0-9A-Fa-f]{2}-){5}[0-9A-Fa-f]{2}$`)
+
+func CheckMACAddress(s string) error {
+	if !macPattern.MatchString(s) {
+		return fmt.Errorf("%q is not a valid MAC address (e.g. aa:bb:cc:dd:ee:ff)", s)
+	}
+	return nil
+}
+
+// CheckAddressMember validates an entry of a firewall address group: an
+// address, a network in CIDR notation or a range such as 10.0.0.1-10.0.0.9,
+// all of the IPv4 or, when ipv6 is set, the IPv6 family.
+func CheckAddressMember(s string, ipv6 bool) error {
+	family := "IPv4"
+	if ipv6 {
+		family = "IPv6"
+	}
+	invalid := fmt.Errorf("%q is not an %s address, network in CIDR notation or address range", s, family)
+
+	var addrs []netip.Addr
+	if first, last, isRange := strings.Cut(s, "-"); isRange {
+		a, errA := netip.ParseAddr(first)
+		b, errB := netip.ParseAddr(last)
+		if errA != nil || errB != nil {
+			return invalid
+		}
+		if b.Less(a) {
+			return fmt.Errorf("%q: range starts after it ends", s)
+		}
+		addrs = []netip.Addr{a, b}
+	} else if addr, err := netip.ParseAddr(s); err == nil {
+		addrs = []netip.Addr{addr}
+	} else if prefix, err := netip.ParsePrefix(s); err == nil {
+		addrs = []netip.Addr{prefix.Addr()}
+	} else {
+		return invalid
+	}
+
+	for _, addr := range addrs {
+		if addr.Is4() == ipv6 {
+			return invalid
+		}
+	}
+	return nil
+}
+
+// CheckIPInSubnet reports whether ip is a usable host address of subnet,
+// which may be given in gateway-host form.
+func CheckIPInSubnet(ip, subnet string) error {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid IP address", ip)
+	}
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid subnet", subnet)
+	}
+	if !prefix.Masked().Contains(addr) {
+		return fmt.Errorf("%s is outside the network's subnet %s", ip, prefix.Masked())
+	}
+	if addr == prefix.Masked().Addr() || (addr.Is4() && addr == broadcast(prefix)) {
+		return fmt.Errorf("%s is the network or broadcast address of %s", ip, prefix.Masked())
+	}
+	return nil
+}
+
+// broadcast returns the last address of an IPv4 prefix.
+func broadcast(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().As4()
+	hostBits := 32 - prefix.Bits()
+	for i := 3; i >= 0 && hostBits > 0; i-- {
+		n := min(hostBits, 8)
+		b[i] |= byte(1<<n - 1)
+		hostBits -= n
+	}
+	return netip.AddrFrom4(b)
+}
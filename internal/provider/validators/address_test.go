@@ -0,0 +1,102 @@
+package validators
+
+import "testing"
+
+func TestCheckAddress(t *testing.T) {
+	tests := map[string]struct {
+		check   func(string) error
+		value   string
+		wantErr bool
+	}{
+		"ipv4":                      {check: CheckIPAddress, value: "192.168.1.1"},
+		"ipv6":                      {check: CheckIPAddress, value: "fd00::1"},
+		"ip with prefix":            {check: CheckIPAddress, value: "192.168.1.1/24", wantErr: true},
+		"ip garbage":                {check: CheckIPAddress, value: "192.168.1", wantErr: true},
+		"cidr":                      {check: CheckCIDR, value: "10.0.0.0/8"},
+		"cidr ipv6":                 {check: CheckCIDR, value: "fd00::/64"},
+		"cidr without prefix":       {check: CheckCIDR, value: "10.0.0.0", wantErr: true},
+		"ip or cidr address":        {check: CheckIPOrCIDR, value: "10.0.0.1"},
+		"ip or cidr network":        {check: CheckIPOrCIDR, value: "10.0.0.0/24"},
+		"ip or cidr garbage":        {check: CheckIPOrCIDR, value: "example.com", wantErr: true},
+		"gateway":                   {check: CheckGatewayCIDR, value: "192.168.1.1/24"},
+		"gateway network address":   {check: CheckGatewayCIDR, value: "192.168.1.0/24", wantErr: true},
+		"gateway broadcast address": {check: CheckGatewayCIDR, value: "192.168.1.255/24", wantErr: true},
+		"gateway /31":               {check: CheckGatewayCIDR, value: "192.168.1.1/31", wantErr: true},
+		"gateway ipv6":              {check: CheckGatewayCIDR, value: "fd00::1/64", wantErr: true},
+		"mac colons":                {check: CheckMACAddress, value: "AA:bb:cc:dd:ee:ff"},
+		"mac hyphens":               {check: CheckMACAddress, value: "aa-bb-cc-dd-ee-ff"},
+		"mac mixed separators":      {check: CheckMACAddress, value: "aa:bb-cc:dd-ee:ff", wantErr: true},
+		"mac short":                 {check: CheckMACAddress, value: "aa:bb:cc:dd:ee", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.check(tc.value)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error for %q", tc.value)
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestCheckAddressMember(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		ipv6    bool
+		wantErr bool
+	}{
+		"address":            {value: "10.0.0.1"},
+		"network":            {value: "10.0.0.0/24"},
+		"range":              {value: "10.0.0.1-10.0.0.9"},
+		"reversed range":     {value: "10.0.0.9-10.0.0.1", wantErr: true},
+		"ipv6 in ipv4 group": {value: "fd00::1", wantErr: true},
+		"ipv6 address":       {value: "fd00::1", ipv6: true},
+		"ipv6 range":         {value: "fd00::1-fd00::9", ipv6: true},
+		"ipv4 in ipv6 group": {value: "10.0.0.0/24", ipv6: true, wantErr: true},
+		"mixed range":        {value: "10.0.0.1-fd00::9", wantErr: true},
+		"garbage":            {value: "any", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckAddressMember(tc.value, tc.ipv6)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error for %q", tc.value)
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestCheckIPInSubnet(t *testing.T) {
+	tests := map[string]struct {
+		ip      string
+		subnet  string
+		wantErr bool
+	}{
+		"host":              {ip: "192.168.1.10", subnet: "192.168.1.0/24"},
+		"gateway-host form": {ip: "192.168.1.10", subnet: "192.168.1.1/24"},
+		"outside":           {ip: "192.168.2.10", subnet: "192.168.1.1/24", wantErr: true},
+		"network address":   {ip: "192.168.1.0", subnet: "192.168.1.1/24", wantErr: true},
+		"broadcast address": {ip: "192.168.1.255", subnet: "192.168.1.1/24", wantErr: true},
+		"invalid ip":        {ip: "192.168.1", subnet: "192.168.1.1/24", wantErr: true},
+		"invalid subnet":    {ip: "192.168.1.10", subnet: "192.168.1.1", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckIPInSubnet(tc.ip, tc.subnet)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error for %s in %s", tc.ip, tc.subnet)
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
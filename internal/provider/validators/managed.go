@@ -0,0 +1,22 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ManagedBy rejects value, which the resource manages through the named
+// attribute instead, and points the user to that attribute.
+func ManagedBy(value, attribute string) validator.String {
+	return stringCheckValidator{
+		description: fmt.Sprintf("value must not be %s, which is managed by %s", value, attribute),
+		summary:     "Value Managed By Another Attribute",
+		check: func(s string) error {
+			if s == value {
+				return fmt.Errorf("%s is managed by the %s attribute; set %s instead of listing it", value, attribute, attribute)
+			}
+			return nil
+		},
+	}
+}
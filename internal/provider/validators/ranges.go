@@ -0,0 +1,121 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// The controller reserves VLANs above 4009 for internal use.
+const (
+	minVLAN = 2
+	maxVLAN = 4009
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
+)
+
+var _ validator.Int64 = vlanIDValidator{}
+
+type vlanIDValidator struct{}
+
+// VLANID validates a VLAN ID within the range the controller accepts.
+func VLANID() validator.Int64 {
+	return vlanIDValidator{}
+}
+
+func (v vlanIDValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a VLAN ID between %d and %d", minVLAN, maxVLAN)
+}
+
+func (v vlanIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vlanIDValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if id := req.ConfigValue.ValueInt64(); id < minVLAN || id > maxVLAN {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid VLAN ID",
+			fmt.Sprintf("VLAN ID %d is outside the range the controller accepts (%d-%d).", id, minVLAN, maxVLAN),
+		)
+	}
+}
+
+var _ validator.Int64 = portValidator{}
+
+type portValidator struct{}
+
+// Port validates a single TCP or UDP port number, with the same bounds as
+// PortRange.
+func Port() validator.Int64 {
+	return portValidator{}
+}
+
+func (v portValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a port between %d and %d", minPort, maxPort)
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parsePort(strconv.FormatInt(req.ConfigValue.ValueInt64(), 10)); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Port", err.Error()+".")
+	}
+}
+
+// PortRange validates a port, a range such as 8000-8080, or a
+// comma-separated list of both.
+func PortRange() validator.String {
+	return stringCheckValidator{
+		description: "value must be a port, a port range (e.g. 8000-8080) or a comma-separated list of both",
+		summary:     "Invalid Port Range",
+		check:       CheckPortRange,
+	}
+}
+
+func CheckPortRange(s string) error {
+	if s == "" {
+		return errors.New("port range must not be empty")
+	}
+	for _, part := range strings.Split(s, ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		first, err := parsePort(lo)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		last, err := parsePort(hi)
+		if err != nil {
+			return err
+		}
+		if first > last {
+			return fmt.Errorf("%q: range %s starts after it ends", s, part)
+		}
+	}
+	return nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < minPort || port > maxPort {
+		return 0, fmt.Errorf("%q is not a port between %d and %d", s, minPort, maxPort)
+	}
+	return port, nil
+}
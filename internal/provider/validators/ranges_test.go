@@ -0,0 +1,72 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckPortRange(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		wantErr bool
+	}{
+		"port":           {value: "443"},
+		"range":          {value: "8000-8080"},
+		"list":           {value: "80,443,8000-8080"},
+		"single range":   {value: "8080-8080"},
+		"empty":          {value: "", wantErr: true},
+		"zero":           {value: "0", wantErr: true},
+		"too large":      {value: "65536", wantErr: true},
+		"reversed":       {value: "8080-8000", wantErr: true},
+		"open range":     {value: "8000-", wantErr: true},
+		"trailing comma": {value: "80,", wantErr: true},
+		"spaces":         {value: "80, 443", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckPortRange(tc.value)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error for %q", tc.value)
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestInt64Validators(t *testing.T) {
+	tests := map[string]struct {
+		validator validator.Int64
+		value     types.Int64
+		wantErr   bool
+	}{
+		"vlan lowest":    {validator: VLANID(), value: types.Int64Value(2)},
+		"vlan highest":   {validator: VLANID(), value: types.Int64Value(4009)},
+		"vlan default":   {validator: VLANID(), value: types.Int64Value(1), wantErr: true},
+		"vlan reserved":  {validator: VLANID(), value: types.Int64Value(4010), wantErr: true},
+		"vlan null":      {validator: VLANID(), value: types.Int64Null()},
+		"vlan unknown":   {validator: VLANID(), value: types.Int64Unknown()},
+		"port lowest":    {validator: Port(), value: types.Int64Value(1)},
+		"port highest":   {validator: Port(), value: types.Int64Value(65535)},
+		"port zero":      {validator: Port(), value: types.Int64Value(0), wantErr: true},
+		"port too large": {validator: Port(), value: types.Int64Value(65536), wantErr: true},
+		"port null":      {validator: Port(), value: types.Int64Null()},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root("test"), ConfigValue: tc.value}
+			resp := &validator.Int64Response{}
+			tc.validator.ValidateInt64(context.Background(), req, resp)
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Fatalf("got error %t, want %t: %v", got, tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
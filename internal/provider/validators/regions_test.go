@@ -0,0 +1,28 @@
+package validators
+
+import "testing"
+
+func TestSuggestRegion(t *testing.T) {
+	const noSuggestion = " Use the unifi_regions data source to list the supported codes."
+
+	tests := map[string]struct {
+		value string
+		want  string
+	}{
+		"lowercase code":       {value: "de", want: ` Did you mean "DE"?`},
+		"alias":                {value: "UK", want: ` Did you mean "GB" (United Kingdom)?`},
+		"lowercase alias":      {value: "usa", want: ` Did you mean "US" (United States)?`},
+		"country name":         {value: "Germany", want: ` Did you mean "DE" (Germany)?`},
+		"partial country name": {value: "Switzer", want: ` Did you mean "CH" (Switzerland)?`},
+		"unknown code":         {value: "XX", want: noSuggestion},
+		"unknown name":         {value: "Atlantis", want: noSuggestion},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := suggestRegion(tc.value); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
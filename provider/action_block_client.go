@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &blockClientAction{}
+var _ action.ActionWithConfigure = &blockClientAction{}
+
+func NewBlockClientAction() action.Action {
+	return &blockClientAction{}
+}
+
+type blockClientAction struct {
+	BaseAction
+}
+
+type blockClientActionModel struct {
+	MAC     types.String `tfsdk:"mac"`
+	Blocked types.Bool   `tfsdk:"blocked"`
+}
+
+func (a *blockClientAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_client"
+}
+
+func (a *blockClientAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Blocks a client from the network, or unblocks it.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the client.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+			"blocked": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to block the client. Set to false to unblock it. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (a *blockClientAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data blockClientActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.BlockClient(ctx, utils.NormalizeMAC(data.MAC.ValueString()), data.Blocked.IsNull() || data.Blocked.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Error blocking client", err.Error())
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &forceProvisionAction{}
+var _ action.ActionWithConfigure = &forceProvisionAction{}
+
+func NewForceProvisionAction() action.Action {
+	return &forceProvisionAction{}
+}
+
+type forceProvisionAction struct {
+	BaseAction
+}
+
+type forceProvisionActionModel struct {
+	MAC types.String `tfsdk:"mac"`
+}
+
+func (a *forceProvisionAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_force_provision"
+}
+
+func (a *forceProvisionAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pushes the current configuration to a UniFi device again.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the device.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceProvisionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data forceProvisionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.ForceProvisionDevice(ctx, utils.NormalizeMAC(data.MAC.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error provisioning device", err.Error())
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &kickClientAction{}
+var _ action.ActionWithConfigure = &kickClientAction{}
+
+func NewKickClientAction() action.Action {
+	return &kickClientAction{}
+}
+
+type kickClientAction struct {
+	BaseAction
+}
+
+type kickClientActionModel struct {
+	MAC types.String `tfsdk:"mac"`
+}
+
+func (a *kickClientAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kick_client"
+}
+
+func (a *kickClientAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Disconnects a client. It may reconnect straight away; use `unifi_block_client` to keep it off the network.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the client.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+		},
+	}
+}
+
+func (a *kickClientAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data kickClientActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.KickClient(ctx, utils.NormalizeMAC(data.MAC.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error kicking client", err.Error())
+		return
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccKickClientAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The MAC is given in upper-case, dash-separated form to check
+				// that it reaches the controller normalized.
+				Config: testAccKickClientActionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("terraform_data.trigger", "input", "data.unifi_clients.all", "clients.0.mac"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKickClientActionConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_clients" "all" {}
+
+action "unifi_kick_client" "test" {
+  config {
+    mac = upper(replace(data.unifi_clients.all.clients[0].mac, ":", "-"))
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = data.unifi_clients.all.clients[0].mac
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.unifi_kick_client.test]
+    }
+  }
+}
+`, getProviderConfig())
+}
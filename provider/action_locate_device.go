@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &locateDeviceAction{}
+var _ action.ActionWithConfigure = &locateDeviceAction{}
+
+func NewLocateDeviceAction() action.Action {
+	return &locateDeviceAction{}
+}
+
+type locateDeviceAction struct {
+	BaseAction
+}
+
+type locateDeviceActionModel struct {
+	MAC     types.String `tfsdk:"mac"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (a *locateDeviceAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locate"
+}
+
+func (a *locateDeviceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Blinks the LED of a UniFi device so it can be found, or stops blinking it.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the device.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to start blinking. Set to false to stop. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (a *locateDeviceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data locateDeviceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.LocateDevice(ctx, utils.NormalizeMAC(data.MAC.ValueString()), data.Enabled.IsNull() || data.Enabled.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Error locating device", err.Error())
+		return
+	}
+}
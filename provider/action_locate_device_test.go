@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccLocateDeviceAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Start blinking the first device when the trigger is created,
+				// then stop again so the device is left as it was found.
+				Config: testAccLocateDeviceActionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("terraform_data.trigger", "input", "data.unifi_devices.all", "devices.0.mac"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLocateDeviceActionConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_devices" "all" {}
+
+action "unifi_locate" "start" {
+  config {
+    mac = data.unifi_devices.all.devices[0].mac
+  }
+}
+
+action "unifi_locate" "stop" {
+  config {
+    mac     = data.unifi_devices.all.devices[0].mac
+    enabled = false
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = data.unifi_devices.all.devices[0].mac
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.unifi_locate.start, action.unifi_locate.stop]
+    }
+  }
+}
+`, getProviderConfig())
+}
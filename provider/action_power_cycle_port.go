@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &powerCyclePortAction{}
+var _ action.ActionWithConfigure = &powerCyclePortAction{}
+
+func NewPowerCyclePortAction() action.Action {
+	return &powerCyclePortAction{}
+}
+
+type powerCyclePortAction struct {
+	BaseAction
+}
+
+type powerCyclePortActionModel struct {
+	MAC     types.String `tfsdk:"mac"`
+	PortIdx types.Int64  `tfsdk:"port_idx"`
+}
+
+func (a *powerCyclePortAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_power_cycle_port"
+}
+
+func (a *powerCyclePortAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Briefly cuts PoE power on a switch port, restarting the device it powers.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the switch.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+			"port_idx": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The index of the switch port.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *powerCyclePortAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data powerCyclePortActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.PowerCycleSwitchPort(ctx, utils.NormalizeMAC(data.MAC.ValueString()), int(data.PortIdx.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError("Error power cycling port", err.Error())
+		return
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &restartDeviceAction{}
+var _ action.ActionWithConfigure = &restartDeviceAction{}
+
+func NewRestartDeviceAction() action.Action {
+	return &restartDeviceAction{}
+}
+
+type restartDeviceAction struct {
+	BaseAction
+}
+
+type restartDeviceActionModel struct {
+	MAC  types.String `tfsdk:"mac"`
+	Hard types.Bool   `tfsdk:"hard"`
+}
+
+func (a *restartDeviceAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restart_device"
+}
+
+func (a *restartDeviceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restarts a UniFi device.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the device.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+			"hard": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also cut PoE power to the devices a switch powers. Defaults to false.",
+			},
+		},
+	}
+}
+
+func (a *restartDeviceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data restartDeviceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.RestartDevice(ctx, utils.NormalizeMAC(data.MAC.ValueString()), data.Hard.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Error restarting device", err.Error())
+		return
+	}
+}
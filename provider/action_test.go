@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+// commandRecorder is a fake controller that records the cmd/<manager>
+// requests it receives.
+type commandRecorder struct {
+	paths    []string
+	payloads []map[string]any
+}
+
+func newCommandRecorder(t *testing.T) (*commandRecorder, *client.Client) {
+	t.Helper()
+	rec := &commandRecorder{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || !strings.Contains(r.URL.Path, "/cmd/") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding payload: %s", err)
+		}
+		rec.paths = append(rec.paths, r.URL.Path)
+		rec.payloads = append(rec.payloads, payload)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	standalone := true
+	c, err := client.NewClient(srv.URL, "", "", "key", "default", &standalone, client.TransportConfig{})
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	return rec, c
+}
+
+func TestActionInvoke(t *testing.T) {
+	mac := tftypes.NewValue(tftypes.String, "AA-BB-CC-DD-EE-FF")
+	const normalized = "aa:bb:cc:dd:ee:ff"
+
+	tests := map[string]struct {
+		action   action.Action
+		config   map[string]tftypes.Value
+		wantPath string
+		want     map[string]any
+	}{
+		"locate": {
+			action:   NewLocateDeviceAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "set-locate", "mac": normalized},
+		},
+		"locate disabled": {
+			action: NewLocateDeviceAction(),
+			config: map[string]tftypes.Value{
+				"mac":     mac,
+				"enabled": tftypes.NewValue(tftypes.Bool, false),
+			},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "unset-locate", "mac": normalized},
+		},
+		"kick client": {
+			action:   NewKickClientAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/stamgr",
+			want:     map[string]any{"cmd": "kick-sta", "mac": normalized},
+		},
+		"block client": {
+			action:   NewBlockClientAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/stamgr",
+			want:     map[string]any{"cmd": "block-sta", "mac": normalized},
+		},
+		"unblock client": {
+			action: NewBlockClientAction(),
+			config: map[string]tftypes.Value{
+				"mac":     mac,
+				"blocked": tftypes.NewValue(tftypes.Bool, false),
+			},
+			wantPath: "/api/s/default/cmd/stamgr",
+			want:     map[string]any{"cmd": "unblock-sta", "mac": normalized},
+		},
+		"restart": {
+			action:   NewRestartDeviceAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "restart", "mac": normalized, "reboot_type": "soft"},
+		},
+		"hard restart": {
+			action: NewRestartDeviceAction(),
+			config: map[string]tftypes.Value{
+				"mac":  mac,
+				"hard": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "restart", "mac": normalized, "reboot_type": "hard"},
+		},
+		"force provision": {
+			action:   NewForceProvisionAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "force-provision", "mac": normalized},
+		},
+		"power cycle port": {
+			action: NewPowerCyclePortAction(),
+			config: map[string]tftypes.Value{
+				"mac":      mac,
+				"port_idx": tftypes.NewValue(tftypes.Number, 7),
+			},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "power-cycle", "mac": normalized, "port_idx": float64(7)},
+		},
+		"upgrade firmware": {
+			action:   NewUpgradeFirmwareAction(),
+			config:   map[string]tftypes.Value{"mac": mac},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "upgrade", "mac": normalized},
+		},
+		"upgrade firmware from url": {
+			action: NewUpgradeFirmwareAction(),
+			config: map[string]tftypes.Value{
+				"mac": mac,
+				"url": tftypes.NewValue(tftypes.String, "https://example.com/fw.bin"),
+			},
+			wantPath: "/api/s/default/cmd/devmgr",
+			want:     map[string]any{"cmd": "upgrade-external", "mac": normalized, "url": "https://example.com/fw.bin"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec, c := newCommandRecorder(t)
+			if diags := runAction(t, tc.action, c, tc.config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(rec.payloads) != 1 {
+				t.Fatalf("got %d commands, want 1", len(rec.payloads))
+			}
+			if rec.paths[0] != tc.wantPath {
+				t.Errorf("got path %q, want %q", rec.paths[0], tc.wantPath)
+			}
+			if !reflect.DeepEqual(rec.payloads[0], tc.want) {
+				t.Errorf("got payload %v, want %v", rec.payloads[0], tc.want)
+			}
+		})
+	}
+}
+
+func TestActionValidation(t *testing.T) {
+	badMAC := map[string]tftypes.Value{"mac": tftypes.NewValue(tftypes.String, "not-a-mac")}
+
+	tests := map[string]struct {
+		action  action.Action
+		config  map[string]tftypes.Value
+		wantErr string
+	}{
+		"locate":           {action: NewLocateDeviceAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"kick client":      {action: NewKickClientAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"block client":     {action: NewBlockClientAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"restart":          {action: NewRestartDeviceAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"force provision":  {action: NewForceProvisionAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"upgrade firmware": {action: NewUpgradeFirmwareAction(), config: badMAC, wantErr: "Invalid MAC Address"},
+		"power cycle port mac": {
+			action: NewPowerCyclePortAction(),
+			config: map[string]tftypes.Value{
+				"mac":      tftypes.NewValue(tftypes.String, "not-a-mac"),
+				"port_idx": tftypes.NewValue(tftypes.Number, 1),
+			},
+			wantErr: "Invalid MAC Address",
+		},
+		"power cycle port index": {
+			action: NewPowerCyclePortAction(),
+			config: map[string]tftypes.Value{
+				"mac":      tftypes.NewValue(tftypes.String, "aa:bb:cc:dd:ee:ff"),
+				"port_idx": tftypes.NewValue(tftypes.Number, 0),
+			},
+			wantErr: "Invalid Attribute Value",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec, c := newCommandRecorder(t)
+			diags := runAction(t, tc.action, c, tc.config)
+			if !diags.HasError() {
+				t.Fatal("expected a validation error")
+			}
+			if got := diags.Errors()[0].Summary(); got != tc.wantErr {
+				t.Errorf("got %q, want %q", got, tc.wantErr)
+			}
+			if len(rec.payloads) != 0 {
+				t.Errorf("got %d commands sent for invalid config, want none", len(rec.payloads))
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ action.Action = &upgradeFirmwareAction{}
+var _ action.ActionWithConfigure = &upgradeFirmwareAction{}
+
+func NewUpgradeFirmwareAction() action.Action {
+	return &upgradeFirmwareAction{}
+}
+
+type upgradeFirmwareAction struct {
+	BaseAction
+}
+
+type upgradeFirmwareActionModel struct {
+	MAC types.String `tfsdk:"mac"`
+	URL types.String `tfsdk:"url"`
+}
+
+func (a *upgradeFirmwareAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upgrade_firmware"
+}
+
+func (a *upgradeFirmwareAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Upgrades the firmware of a UniFi device. The device restarts once the upgrade is installed.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The MAC address of the device.",
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of a firmware image to install. Defaults to the latest firmware the controller offers.",
+			},
+		},
+	}
+}
+
+func (a *upgradeFirmwareAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data upgradeFirmwareActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.Client.UpgradeDevice(ctx, utils.NormalizeMAC(data.MAC.ValueString()), data.URL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error upgrading firmware", err.Error())
+		return
+	}
+}
@@ -2,10 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 )
 
@@ -31,6 +41,173 @@ func (r *BaseResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.Client = c
 }
 
+// resourceIdentityModel is the identity shared by every resource: the ID the
+// controller assigned to the object.
+type resourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *BaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the object on the controller.",
+			},
+		},
+	}
+}
+
+// requireVersion adds an error to diags when the controller runs a Network
+// application older than min. Version detection failures are ignored so that
+// planning never depends on them.
+func (r *BaseResource) requireVersion(ctx context.Context, feature, min string, diags *diag.Diagnostics) {
+	if r.Client == nil {
+		return
+	}
+
+	var versionErr *client.VersionError
+	if errors.As(r.Client.RequireVersion(ctx, feature, min), &versionErr) {
+		diags.AddError(
+			"Unsupported UniFi Network Version",
+			versionErr.Error()+". Upgrade the Network application on the controller, or remove "+feature+" from the configuration.",
+		)
+	}
+}
+
+// waitForProvision waits, when enabled, for devices of the given types to
+// finish applying a change. The change itself has already been saved, so
+// devices that do not converge in time are reported as a warning.
+func (r *BaseResource) waitForProvision(ctx context.Context, enabled types.Bool, diags *diag.Diagnostics, deviceTypes ...string) {
+	if !enabled.ValueBool() {
+		return
+	}
+
+	err := r.Client.WaitForProvision(ctx, deviceTypes...)
+	var provisionErr *client.ProvisionError
+	switch {
+	case errors.As(err, &provisionErr):
+		diags.AddWarning(
+			"Devices Did Not Finish Provisioning",
+			provisionErr.Error()+". The change was applied, but these devices had not converged when the operation timed out.",
+		)
+	case err != nil:
+		diags.AddWarning("Error Waiting for Provisioning", err.Error())
+	}
+}
+
+// waitForProvisionAttribute is the schema of the wait_for_provision
+// attribute.
+func waitForProvisionAttribute(devices string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: "Wait after each change until " + devices + " have finished provisioning the new configuration, " +
+			"bounded by the operation's timeout. Devices that do not converge in time are reported as a warning.",
+	}
+}
+
+// defaultTimeout applies to operations whose timeouts block leaves the
+// duration unset. It covers a busy controller, including the client's retries.
+const defaultTimeout = 10 * time.Minute
+
+// withTimeout bounds ctx by the operation's configured timeout. Every request
+// made with the returned context, along with its retries and rate-limit
+// waits, stops once the timeout is reached.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+	return context.WithTimeout(ctx, d)
+}
+
+// isBuiltIn reports whether the controller marks an object as built in, such
+// as the Default LAN or user group. Built-in objects cannot be deleted.
+func isBuiltIn(noDelete *bool, hiddenID string) bool {
+	return (noDelete != nil && *noDelete) || hiddenID != ""
+}
+
+// warnBuiltInNotDeleted explains why Delete left a built-in object in place.
+// Returning from Delete without an error still removes it from state.
+func warnBuiltInNotDeleted(diags *diag.Diagnostics, kind, name string) {
+	diags.AddWarning(
+		"Built-in Object Not Deleted",
+		fmt.Sprintf("The %s %q is built into the controller and cannot be deleted. It has been removed from Terraform state but remains on the controller.", kind, name),
+	)
+}
+
+// findAdoptable returns the existing object called name when adopt is set, so
+// that Create can take it over instead of failing on a duplicate.
+func findAdoptable[T any](ctx context.Context, adopt types.Bool, name string, list func(context.Context) ([]T, error), nameOf func(*T) string) (*T, error) {
+	if !adopt.ValueBool() {
+		return nil, nil
+	}
+
+	items, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if nameOf(&items[i]) == name {
+			return &items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// adoptExistingAttribute is the schema of the adopt_existing attribute.
+func adoptExistingAttribute(kind string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf("Take over an existing %[1]s with the same name on create, such as a built-in default, instead of creating a new one. "+
+			"Built-in %[1]ss are only removed from state on destroy.", kind),
+	}
+}
+
+// listResults streams a list result for each item, identified by its
+// controller ID. The full resource is only built when Terraform asks for it,
+// starting from a state where every attribute is null so that sync only has
+// to fill in what the controller returns.
+func listResults[T, M any](ctx context.Context, req list.ListRequest, items []T, describe func(*T) (id, name string), sync func(*M, *T)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			id, name := describe(&items[i])
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(result.Identity.Set(ctx, resourceIdentityModel{ID: types.StringValue(id)})...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				objectType := req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+				attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+				for name, t := range objectType.AttributeTypes {
+					attributes[name] = tftypes.NewValue(t, nil)
+				}
+				result.Resource.Raw = tftypes.NewValue(objectType, attributes)
+
+				var data M
+				result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+				if !result.Diagnostics.HasError() {
+					sync(&data, &items[i])
+					result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listError streams a single error, for a list that could not be fetched.
+func listError(summary string, err error) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, err.Error())
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
 // BaseDataSource implements common methods for all data sources.
 type BaseDataSource struct {
 	Client *client.Client
@@ -52,3 +229,25 @@ func (d *BaseDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 
 	d.Client = c
 }
+
+// BaseAction implements common methods for all actions.
+type BaseAction struct {
+	Client *client.Client
+}
+
+func (a *BaseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.Client = c
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &adminsDataSource{}
+
+func NewAdminsDataSource() datasource.DataSource {
+	return &adminsDataSource{}
+}
+
+type adminsDataSource struct {
+	BaseDataSource
+}
+
+type adminsDataSourceModel struct {
+	Admins types.List `tfsdk:"admins"`
+}
+
+type adminDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Permissions types.List   `tfsdk:"permissions"`
+	IsSuper     types.Bool   `tfsdk:"is_super"`
+}
+
+var adminDataAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"email":       types.StringType,
+	"role":        types.StringType,
+	"permissions": types.ListType{ElemType: types.StringType},
+	"is_super":    types.BoolType,
+}
+
+func (d *adminsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admins"
+}
+
+func (d *adminsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the administrators of the current UniFi site, e.g. for access audits.",
+		Attributes: map[string]schema.Attribute{
+			"admins": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the administrator.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the administrator.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email address of the administrator.",
+						},
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role of the administrator on the site.",
+						},
+						"permissions": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The site permissions of the administrator.",
+						},
+						"is_super": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the administrator is a super administrator of the controller.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The administrators of the site.",
+			},
+		},
+	}
+}
+
+func (d *adminsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data adminsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admins, err := d.Client.ListAdmins(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing admins", err.Error())
+		return
+	}
+
+	items := make([]adminDataModel, len(admins))
+	for i, a := range admins {
+		permissions, _ := types.ListValueFrom(ctx, types.StringType, a.Permissions)
+		items[i] = adminDataModel{
+			ID:          types.StringValue(a.ID),
+			Name:        types.StringValue(a.Name),
+			Email:       types.StringValue(a.Email),
+			Role:        types.StringValue(a.Role),
+			Permissions: permissions,
+			IsSuper:     utils.BoolValue(a.IsSuper),
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: adminDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Admins = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
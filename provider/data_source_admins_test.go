@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_admins.test", "admins.#"),
+					resource.TestCheckResourceAttrSet("data.unifi_admins.test", "admins.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAdminsDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_admins" "test" {}
+`, getProviderConfig())
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &apGroupsDataSource{}
+
+func NewAPGroupsDataSource() datasource.DataSource {
+	return &apGroupsDataSource{}
+}
+
+type apGroupsDataSource struct {
+	BaseDataSource
+}
+
+type apGroupsDataSourceModel struct {
+	Filter   types.Object `tfsdk:"filter"`
+	APGroups types.List   `tfsdk:"ap_groups"`
+}
+
+type apGroupDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DeviceMACs  types.List   `tfsdk:"device_macs"`
+	ForWLANConf types.Bool   `tfsdk:"for_wlanconf"`
+}
+
+var apGroupDataAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"name":         types.StringType,
+	"device_macs":  types.ListType{ElemType: types.StringType},
+	"for_wlanconf": types.BoolType,
+}
+
+func (d *apGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ap_groups"
+}
+
+func (d *apGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi AP groups of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("", "", false),
+			"ap_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the AP group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the AP group.",
+						},
+						"device_macs": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The MAC addresses of the devices in the AP group.",
+						},
+						"for_wlanconf": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the AP group is used for WLAN configuration.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching AP groups.",
+			},
+		},
+	}
+}
+
+func (d *apGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.Client.ListAPGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing AP groups", err.Error())
+		return
+	}
+
+	items := []apGroupDataModel{}
+	for _, g := range groups {
+		if !filter.match(g.Name, "", nil) {
+			continue
+		}
+		macs, _ := types.ListValueFrom(ctx, types.StringType, g.DeviceMACs)
+		items = append(items, apGroupDataModel{
+			ID:          types.StringValue(g.ID),
+			Name:        types.StringValue(g.Name),
+			DeviceMACs:  macs,
+			ForWLANConf: utils.BoolValue(g.ForWLANConf),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: apGroupDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.APGroups = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &clientsDataSource{}
+
+func NewClientsDataSource() datasource.DataSource {
+	return &clientsDataSource{}
+}
+
+type clientsDataSource struct {
+	BaseDataSource
+}
+
+type clientsDataSourceModel struct {
+	NetworkID      types.String `tfsdk:"network_id"`
+	Wired          types.Bool   `tfsdk:"wired"`
+	Blocked        types.Bool   `tfsdk:"blocked"`
+	NameRegex      types.String `tfsdk:"name_regex"`
+	LastSeenWithin types.String `tfsdk:"last_seen_within"`
+	Clients        types.List   `tfsdk:"clients"`
+}
+
+type clientDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	MAC         types.String `tfsdk:"mac"`
+	Name        types.String `tfsdk:"name"`
+	Hostname    types.String `tfsdk:"hostname"`
+	IP          types.String `tfsdk:"ip"`
+	FixedIP     types.String `tfsdk:"fixed_ip"`
+	NetworkID   types.String `tfsdk:"network_id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	ESSID       types.String `tfsdk:"essid"`
+	IsWired     types.Bool   `tfsdk:"is_wired"`
+	IsGuest     types.Bool   `tfsdk:"is_guest"`
+	Blocked     types.Bool   `tfsdk:"blocked"`
+	Active      types.Bool   `tfsdk:"active"`
+	OUI         types.String `tfsdk:"oui"`
+	FirstSeen   types.Int64  `tfsdk:"first_seen"`
+	LastSeen    types.Int64  `tfsdk:"last_seen"`
+}
+
+var clientDataAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"mac":           types.StringType,
+	"name":          types.StringType,
+	"hostname":      types.StringType,
+	"ip":            types.StringType,
+	"fixed_ip":      types.StringType,
+	"network_id":    types.StringType,
+	"user_group_id": types.StringType,
+	"essid":         types.StringType,
+	"is_wired":      types.BoolType,
+	"is_guest":      types.BoolType,
+	"blocked":       types.BoolType,
+	"active":        types.BoolType,
+	"oui":           types.StringType,
+	"first_seen":    types.Int64Type,
+	"last_seen":     types.Int64Type,
+}
+
+// clientEntry merges the known client record (rest/user) with its live
+// session (stat/sta); either side may be missing.
+type clientEntry struct {
+	user   *client.User
+	active *client.ActiveClient
+}
+
+func (e *clientEntry) mac() string {
+	if e.user != nil {
+		return e.user.MAC
+	}
+	return e.active.MAC
+}
+
+func (e *clientEntry) name() string {
+	if e.user != nil && e.user.Name != "" {
+		return e.user.Name
+	}
+	if e.active != nil && e.active.Name != "" {
+		return e.active.Name
+	}
+	if e.active != nil {
+		return e.active.Hostname
+	}
+	return ""
+}
+
+func (e *clientEntry) networkID() string {
+	if e.user != nil && e.user.NetworkID != "" {
+		return e.user.NetworkID
+	}
+	if e.active != nil {
+		return e.active.NetworkID
+	}
+	return ""
+}
+
+func (e *clientEntry) isWired() *bool {
+	if e.active != nil && e.active.IsWired != nil {
+		return e.active.IsWired
+	}
+	if e.user != nil {
+		return e.user.IsWired
+	}
+	return nil
+}
+
+func (e *clientEntry) blocked() bool {
+	if e.user != nil && e.user.Blocked != nil {
+		return *e.user.Blocked
+	}
+	return e.active != nil && e.active.Blocked != nil && *e.active.Blocked
+}
+
+func (e *clientEntry) lastSeen() *int64 {
+	var last *int64
+	if e.user != nil {
+		last = e.user.LastSeen
+	}
+	if e.active != nil && e.active.LastSeen != nil && (last == nil || *e.active.LastSeen > *last) {
+		last = e.active.LastSeen
+	}
+	return last
+}
+
+func (e *clientEntry) toModel() clientDataModel {
+	model := clientDataModel{
+		MAC:       types.StringValue(e.mac()),
+		Name:      utils.StringToValue(e.name()),
+		NetworkID: utils.StringToValue(e.networkID()),
+		IsWired:   utils.BoolValue(e.isWired()),
+		Blocked:   types.BoolValue(e.blocked()),
+		Active:    types.BoolValue(e.active != nil),
+		LastSeen:  utils.Int64PtrValue(e.lastSeen()),
+	}
+
+	if e.user != nil {
+		model.ID = types.StringValue(e.user.ID)
+		model.FixedIP = utils.StringToValue(e.user.FixedIP)
+		model.UserGroupID = utils.StringToValue(e.user.UsergroupID)
+		model.IsGuest = utils.BoolValue(e.user.IsGuest)
+		model.OUI = utils.StringToValue(e.user.OUI)
+		model.FirstSeen = utils.Int64PtrValue(e.user.FirstSeen)
+	} else {
+		model.ID = utils.StringToValue(e.active.UserID)
+		model.FixedIP = types.StringNull()
+		model.UserGroupID = types.StringNull()
+		model.IsGuest = utils.BoolValue(e.active.IsGuest)
+		model.OUI = utils.StringToValue(e.active.OUI)
+		model.FirstSeen = utils.Int64PtrValue(e.active.FirstSeen)
+	}
+
+	if e.active != nil {
+		model.Hostname = utils.StringToValue(e.active.Hostname)
+		model.IP = utils.StringToValue(e.active.IP)
+		model.ESSID = utils.StringToValue(e.active.ESSID)
+	} else {
+		model.Hostname = types.StringNull()
+		model.IP = types.StringNull()
+		model.ESSID = types.StringNull()
+	}
+	return model
+}
+
+func (d *clientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clients"
+}
+
+func (d *clientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the clients known to the UniFi site, merging the connected clients with the stored client records. " +
+			"Useful to derive `unifi_user` reservations or firewall group members from what is actually on the network.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clients on this network.",
+			},
+			"wired": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return wired clients when true, or wireless clients when false.",
+			},
+			"blocked": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return blocked clients when true, or unblocked clients when false.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clients whose name (or hostname, if unnamed) matches this regular expression.",
+			},
+			"last_seen_within": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clients seen within this duration (e.g., `24h`, `30m`).",
+			},
+			"clients": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the client record, usable as a `unifi_user` import ID.",
+						},
+						"mac": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MAC address of the client.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the client, falling back to its hostname.",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname reported by a connected client.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The current IP address of a connected client.",
+						},
+						"fixed_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fixed IP address reserved for the client.",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the network the client belongs to.",
+						},
+						"user_group_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user group of the client.",
+						},
+						"essid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SSID a connected wireless client is associated with.",
+						},
+						"is_wired": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the client is wired.",
+						},
+						"is_guest": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the client is a guest.",
+						},
+						"blocked": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the client is blocked.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the client is currently connected.",
+						},
+						"oui": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The vendor of the client, derived from its MAC address.",
+						},
+						"first_seen": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "When the client was first seen, as a Unix timestamp.",
+						},
+						"last_seen": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "When the client was last seen, as a Unix timestamp.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching clients.",
+			},
+		},
+	}
+}
+
+func (d *clientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clientsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	var seenAfter int64
+	if !data.LastSeenWithin.IsNull() {
+		window, err := time.ParseDuration(data.LastSeenWithin.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("last_seen_within"), "Invalid last_seen_within", err.Error())
+			return
+		}
+		seenAfter = time.Now().Add(-window).Unix()
+	}
+
+	users, err := d.Client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing clients", err.Error())
+		return
+	}
+
+	active, err := d.Client.ListActiveClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing active clients", err.Error())
+		return
+	}
+
+	entries := make([]*clientEntry, 0, len(users))
+	byMAC := make(map[string]*clientEntry, len(users))
+	for i := range users {
+		e := &clientEntry{user: &users[i]}
+		entries = append(entries, e)
+		byMAC[strings.ToLower(users[i].MAC)] = e
+	}
+	for i := range active {
+		if e, ok := byMAC[strings.ToLower(active[i].MAC)]; ok {
+			e.active = &active[i]
+			continue
+		}
+		entries = append(entries, &clientEntry{active: &active[i]})
+	}
+
+	items := []clientDataModel{}
+	for _, e := range entries {
+		if !data.NetworkID.IsNull() && e.networkID() != data.NetworkID.ValueString() {
+			continue
+		}
+		if !data.Wired.IsNull() {
+			wired := e.isWired()
+			if wired == nil || *wired != data.Wired.ValueBool() {
+				continue
+			}
+		}
+		if !data.Blocked.IsNull() && e.blocked() != data.Blocked.ValueBool() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(e.name()) {
+			continue
+		}
+		if seenAfter != 0 {
+			last := e.lastSeen()
+			if e.active == nil && (last == nil || *last < seenAfter) {
+				continue
+			}
+		}
+		items = append(items, e.toModel())
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: clientDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Clients = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClientsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_clients.all", "clients.#"),
+					resource.TestCheckResourceAttrSet("data.unifi_clients.all", "clients.0.mac"),
+					resource.TestCheckResourceAttrSet("data.unifi_clients.recent_wired", "clients.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientsDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_clients" "all" {}
+
+data "unifi_clients" "recent_wired" {
+  wired            = true
+  last_seen_within = "24h"
+}
+`, getProviderConfig())
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &controllerDataSource{}
+
+func NewControllerDataSource() datasource.DataSource {
+	return &controllerDataSource{}
+}
+
+type controllerDataSource struct {
+	BaseDataSource
+}
+
+type controllerDataSourceModel struct {
+	Site            types.String `tfsdk:"site"`
+	Version         types.String `tfsdk:"version"`
+	Build           types.String `tfsdk:"build"`
+	Hostname        types.String `tfsdk:"hostname"`
+	Name            types.String `tfsdk:"name"`
+	Timezone        types.String `tfsdk:"timezone"`
+	IsUniFiOS       types.Bool   `tfsdk:"is_unifi_os"`
+	ConsoleVersion  types.String `tfsdk:"console_version"`
+	ConsoleName     types.String `tfsdk:"console_name"`
+	ConsoleHardware types.String `tfsdk:"console_hardware"`
+	UpdateAvailable types.Bool   `tfsdk:"update_available"`
+}
+
+func (d *controllerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller"
+}
+
+func (d *controllerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the version and host details of the UniFi controller the provider is connected to.",
+		Attributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The site the provider manages.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the UniFi Network application.",
+			},
+			"build": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The build of the UniFi Network application.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname of the controller.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the controller.",
+			},
+			"timezone": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timezone of the controller.",
+			},
+			"is_unifi_os": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the controller runs on a UniFi OS console rather than as a standalone Network application.",
+			},
+			"console_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UniFi OS version of the console.",
+			},
+			"console_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the UniFi OS console.",
+			},
+			"console_hardware": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hardware model of the UniFi OS console (e.g., UDMPRO, UCGMAX).",
+			},
+			"update_available": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether a Network application update is available.",
+			},
+		},
+	}
+}
+
+func (d *controllerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data controllerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.Client.SysInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading controller info", err.Error())
+		return
+	}
+
+	consoleVersion := info.ConsoleDisplayVersion
+	if consoleVersion == "" {
+		consoleVersion = info.UDMVersion
+	}
+
+	data.Site = types.StringValue(d.Client.Site)
+	data.Version = types.StringValue(info.Version)
+	data.Build = utils.StringToValue(info.Build)
+	data.Hostname = utils.StringToValue(info.Hostname)
+	data.Name = utils.StringToValue(info.Name)
+	data.Timezone = utils.StringToValue(info.Timezone)
+	data.IsUniFiOS = types.BoolValue(info.IsUniFiOS)
+	data.ConsoleVersion = utils.StringToValue(consoleVersion)
+	data.ConsoleName = utils.StringToValue(info.ConsoleName)
+	data.ConsoleHardware = utils.StringToValue(info.ConsoleHardware)
+	data.UpdateAvailable = utils.BoolValue(info.UpdateAvailable)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccControllerDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControllerDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_controller.test", "version"),
+					resource.TestCheckResourceAttr("data.unifi_controller.test", "is_unifi_os", "false"),
+					resource.TestCheckResourceAttr("data.unifi_controller.test", "site", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccControllerDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_controller" "test" {}
+`, getProviderConfig())
+}
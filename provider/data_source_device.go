@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &deviceDataSource{}
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &deviceDataSource{}
+}
+
+type deviceDataSource struct {
+	BaseDataSource
+}
+
+func (d *deviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *deviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deviceComputedAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the device.",
+	}
+	attributes["mac"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The MAC address of the device.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The name of the device.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves information about a UniFi device, including its ports and radios.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data deviceDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devices, err := d.Client.ListDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing devices", err.Error())
+		return
+	}
+
+	found := false
+	for _, dev := range devices {
+		if (!data.ID.IsNull() && dev.ID == data.ID.ValueString()) ||
+			(!data.MAC.IsNull() && strings.EqualFold(dev.MAC, data.MAC.ValueString())) ||
+			(!data.Name.IsNull() && dev.Name == data.Name.ValueString()) {
+			mac := data.MAC
+			data = flattenDevice(ctx, dev)
+			// Keep the configured MAC so differing case does not produce an inconsistent result.
+			if !mac.IsNull() {
+				data.MAC = mac
+			}
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Device not found", "Could not find a device with the provided ID, MAC or Name")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
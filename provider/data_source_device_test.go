@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unifi_device.test", "id", "data.unifi_devices.all", "devices.0.id"),
+					resource.TestCheckResourceAttrSet("data.unifi_device.test", "model"),
+					resource.TestCheckResourceAttrSet("data.unifi_device.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_devices" "all" {}
+
+data "unifi_device" "test" {
+  mac = data.unifi_devices.all.devices[0].mac
+}
+`, getProviderConfig())
+}
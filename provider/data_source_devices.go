@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &devicesDataSource{}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &devicesDataSource{}
+}
+
+type devicesDataSource struct {
+	BaseDataSource
+}
+
+type devicesDataSourceModel struct {
+	Type    types.String `tfsdk:"type"`
+	Model   types.String `tfsdk:"model"`
+	Name    types.String `tfsdk:"name"`
+	Devices types.List   `tfsdk:"devices"`
+}
+
+// deviceDataModel is shared by the unifi_device and unifi_devices data sources.
+type deviceDataModel struct {
+	ID         types.String `tfsdk:"id"`
+	MAC        types.String `tfsdk:"mac"`
+	Name       types.String `tfsdk:"name"`
+	Model      types.String `tfsdk:"model"`
+	Type       types.String `tfsdk:"type"`
+	Version    types.String `tfsdk:"version"`
+	IP         types.String `tfsdk:"ip"`
+	Adopted    types.Bool   `tfsdk:"adopted"`
+	State      types.Int64  `tfsdk:"state"`
+	UplinkMAC  types.String `tfsdk:"uplink_mac"`
+	UplinkPort types.Int64  `tfsdk:"uplink_port"`
+	Ports      types.List   `tfsdk:"ports"`
+	Radios     types.List   `tfsdk:"radios"`
+}
+
+type devicePortModel struct {
+	Index         types.Int64  `tfsdk:"index"`
+	Name          types.String `tfsdk:"name"`
+	Media         types.String `tfsdk:"media"`
+	Up            types.Bool   `tfsdk:"up"`
+	Speed         types.Int64  `tfsdk:"speed"`
+	PoeMode       types.String `tfsdk:"poe_mode"`
+	PortProfileID types.String `tfsdk:"port_profile_id"`
+}
+
+type deviceRadioModel struct {
+	Name        types.String `tfsdk:"name"`
+	Radio       types.String `tfsdk:"radio"`
+	Channel     types.String `tfsdk:"channel"`
+	Width       types.String `tfsdk:"width"`
+	TxPowerMode types.String `tfsdk:"tx_power_mode"`
+	TxPower     types.String `tfsdk:"tx_power"`
+}
+
+var devicePortAttrTypes = map[string]attr.Type{
+	"index":           types.Int64Type,
+	"name":            types.StringType,
+	"media":           types.StringType,
+	"up":              types.BoolType,
+	"speed":           types.Int64Type,
+	"poe_mode":        types.StringType,
+	"port_profile_id": types.StringType,
+}
+
+var deviceRadioAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"radio":         types.StringType,
+	"channel":       types.StringType,
+	"width":         types.StringType,
+	"tx_power_mode": types.StringType,
+	"tx_power":      types.StringType,
+}
+
+var deviceAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"mac":         types.StringType,
+	"name":        types.StringType,
+	"model":       types.StringType,
+	"type":        types.StringType,
+	"version":     types.StringType,
+	"ip":          types.StringType,
+	"adopted":     types.BoolType,
+	"state":       types.Int64Type,
+	"uplink_mac":  types.StringType,
+	"uplink_port": types.Int64Type,
+	"ports":       types.ListType{ElemType: types.ObjectType{AttrTypes: devicePortAttrTypes}},
+	"radios":      types.ListType{ElemType: types.ObjectType{AttrTypes: deviceRadioAttrTypes}},
+}
+
+// deviceComputedAttributes returns the schema of every device attribute
+// except the lookup keys (mac and name), which differ between data sources.
+func deviceComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the device.",
+		},
+		"model": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The hardware model of the device (e.g., U6LR, USW24P).",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of the device (e.g., uap, usw, ugw, udm).",
+		},
+		"version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The firmware version of the device.",
+		},
+		"ip": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The IP address of the device.",
+		},
+		"adopted": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the device is adopted.",
+		},
+		"state": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The device state code (0 disconnected, 1 connected, 4 upgrading, 5 provisioning).",
+		},
+		"uplink_mac": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The MAC address of the upstream device.",
+		},
+		"uplink_port": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The port of the upstream device this device is connected to.",
+		},
+		"ports": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"index": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The port number.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The name of the port.",
+					},
+					"media": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The port media (e.g., GE, SFP+).",
+					},
+					"up": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the port link is up.",
+					},
+					"speed": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The negotiated link speed in Mbps.",
+					},
+					"poe_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The PoE mode of the port.",
+					},
+					"port_profile_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The ID of the port profile applied to the port.",
+					},
+				},
+			},
+			Computed:            true,
+			MarkdownDescription: "The port table of the device.",
+		},
+		"radios": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The interface name of the radio.",
+					},
+					"radio": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The radio band (ng, na, 6e).",
+					},
+					"channel": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The configured channel, or auto.",
+					},
+					"width": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The channel width (e.g., 20, 40, 80).",
+					},
+					"tx_power_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The transmit power mode (auto, low, medium, high, custom).",
+					},
+					"tx_power": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The custom transmit power.",
+					},
+				},
+			},
+			Computed:            true,
+			MarkdownDescription: "The radio table of the device.",
+		},
+	}
+}
+
+func flattenDevice(ctx context.Context, d client.Device) deviceDataModel {
+	ports := make([]devicePortModel, len(d.PortTable))
+	for i, p := range d.PortTable {
+		ports[i] = devicePortModel{
+			Index:         types.Int64Value(int64(p.PortIdx)),
+			Name:          types.StringValue(p.Name),
+			Media:         types.StringValue(p.Media),
+			Up:            utils.BoolValue(p.Up),
+			Speed:         utils.Int64Value(p.Speed),
+			PoeMode:       types.StringValue(p.PoeMode),
+			PortProfileID: types.StringValue(p.PortconfID),
+		}
+	}
+
+	radios := make([]deviceRadioModel, len(d.RadioTable))
+	for i, r := range d.RadioTable {
+		radios[i] = deviceRadioModel{
+			Name:        types.StringValue(r.Name),
+			Radio:       types.StringValue(r.Radio),
+			Channel:     types.StringValue(string(r.Channel)),
+			Width:       types.StringValue(string(r.Ht)),
+			TxPowerMode: types.StringValue(r.TxPowerMode),
+			TxPower:     types.StringValue(string(r.TxPower)),
+		}
+	}
+
+	portList, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: devicePortAttrTypes}, ports)
+	radioList, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deviceRadioAttrTypes}, radios)
+
+	model := deviceDataModel{
+		ID:         types.StringValue(d.ID),
+		MAC:        types.StringValue(d.MAC),
+		Name:       types.StringValue(d.Name),
+		Model:      types.StringValue(d.Model),
+		Type:       types.StringValue(d.Type),
+		Version:    types.StringValue(d.Version),
+		IP:         types.StringValue(d.IP),
+		Adopted:    utils.BoolValue(d.Adopted),
+		State:      types.Int64Value(int64(d.State)),
+		UplinkMAC:  types.StringNull(),
+		UplinkPort: types.Int64Null(),
+		Ports:      portList,
+		Radios:     radioList,
+	}
+	if d.Uplink != nil {
+		model.UplinkMAC = utils.StringToValue(d.Uplink.UplinkMAC)
+		model.UplinkPort = utils.Int64Value(d.Uplink.UplinkRemotePort)
+	}
+	return model
+}
+
+func (d *devicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *devicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	deviceAttributes := deviceComputedAttributes()
+	deviceAttributes["mac"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The MAC address of the device.",
+	}
+	deviceAttributes["name"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the device.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the devices adopted by the UniFi site, with their ports and radios.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices of this type (e.g., uap, usw, ugw, udm).",
+			},
+			"model": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices of this model.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices with this name.",
+			},
+			"devices": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deviceAttributes,
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching devices.",
+			},
+		},
+	}
+}
+
+func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data devicesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devices, err := d.Client.ListDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing devices", err.Error())
+		return
+	}
+
+	items := []deviceDataModel{}
+	for _, dev := range devices {
+		if (!data.Type.IsNull() && dev.Type != data.Type.ValueString()) ||
+			(!data.Model.IsNull() && dev.Model != data.Model.ValueString()) ||
+			(!data.Name.IsNull() && dev.Name != data.Name.ValueString()) {
+			continue
+		}
+		items = append(items, flattenDevice(ctx, dev))
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deviceAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Devices = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
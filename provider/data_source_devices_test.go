@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_devices.all", "devices.#"),
+					resource.TestCheckResourceAttrSet("data.unifi_devices.all", "devices.0.mac"),
+					resource.TestCheckResourceAttrSet("data.unifi_devices.all", "devices.0.model"),
+					resource.TestCheckResourceAttrSet("data.unifi_devices.aps", "devices.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_devices" "all" {}
+
+data "unifi_devices" "aps" {
+  type = "uap"
+}
+`, getProviderConfig())
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &dpiAppDataSource{}
+
+func NewDPIAppDataSource() datasource.DataSource {
+	return &dpiAppDataSource{}
+}
+
+type dpiAppDataSource struct {
+	BaseDataSource
+}
+
+type dpiAppDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	ID          types.Int64  `tfsdk:"id"`
+	MatchedName types.String `tfsdk:"matched_name"`
+	CategoryID  types.String `tfsdk:"category_id"`
+}
+
+func (d *dpiAppDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dpi_app"
+}
+
+func (d *dpiAppDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a DPI application name (e.g., \"Netflix\") to the ID used by `unifi_traffic_rule.app_ids`. " +
+			"Names are matched ignoring case and punctuation, and a unique partial match is accepted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the application to look up.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the application.",
+			},
+			"matched_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The catalogue name of the matched application.",
+			},
+			"category_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the category of the application.",
+			},
+		},
+	}
+}
+
+func (d *dpiAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dpiAppDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := d.Client.ListDPIApps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing DPI applications", err.Error())
+		return
+	}
+
+	names := make([]string, len(apps))
+	for i, a := range apps {
+		names[i] = a.Name
+	}
+
+	idx, candidates := utils.FuzzyFind(data.Name.ValueString(), names, 5)
+	if idx < 0 {
+		resp.Diagnostics.AddError("DPI application not found", dpiNotFoundDetail("application", data.Name.ValueString(), candidates))
+		return
+	}
+
+	app := apps[idx]
+	data.ID = types.Int64Value(int64(app.ID))
+	data.MatchedName = types.StringValue(app.Name)
+	data.CategoryID = types.StringValue(strconv.Itoa(app.CategoryID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func dpiNotFoundDetail(kind, name string, candidates []string) string {
+	if len(candidates) == 0 {
+		return fmt.Sprintf("No DPI %s matches %q.", kind, name)
+	}
+	return fmt.Sprintf("No single DPI %s matches %q. Close candidates: %s.", kind, name, strings.Join(candidates, ", "))
+}
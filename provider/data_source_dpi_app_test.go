@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDPIAppDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDPIAppDataSourceConfig("netflix"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_dpi_app.test", "matched_name", "Netflix"),
+					resource.TestCheckResourceAttrSet("data.unifi_dpi_app.test", "id"),
+					resource.TestCheckResourceAttrSet("data.unifi_dpi_app.test", "category_id"),
+				),
+			},
+			{
+				Config:      testAccDPIAppDataSourceConfig("Netflxi"),
+				ExpectError: regexp.MustCompile(`Close candidates: .*Netflix`),
+			},
+		},
+	})
+}
+
+func testAccDPIAppDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_dpi_app" "test" {
+  name = %q
+}
+`, getProviderConfig(), name)
+}
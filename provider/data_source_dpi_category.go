@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &dpiCategoryDataSource{}
+
+func NewDPICategoryDataSource() datasource.DataSource {
+	return &dpiCategoryDataSource{}
+}
+
+type dpiCategoryDataSource struct {
+	BaseDataSource
+}
+
+type dpiCategoryDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	ID          types.String `tfsdk:"id"`
+	MatchedName types.String `tfsdk:"matched_name"`
+	AppIDs      types.List   `tfsdk:"app_ids"`
+}
+
+func (d *dpiCategoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dpi_category"
+}
+
+func (d *dpiCategoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a DPI category name (e.g., \"Gaming\") to the ID used by `unifi_traffic_rule.app_category_ids`. " +
+			"Names are matched ignoring case and punctuation, and a unique partial match is accepted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the category to look up.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the category.",
+			},
+			"matched_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The catalogue name of the matched category.",
+			},
+			"app_ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the applications in the category.",
+			},
+		},
+	}
+}
+
+func (d *dpiCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dpiCategoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := d.Client.ListDPICategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing DPI categories", err.Error())
+		return
+	}
+
+	names := make([]string, len(categories))
+	for i, c := range categories {
+		names[i] = c.Name
+	}
+
+	idx, candidates := utils.FuzzyFind(data.Name.ValueString(), names, 5)
+	if idx < 0 {
+		resp.Diagnostics.AddError("DPI category not found", dpiNotFoundDetail("category", data.Name.ValueString(), candidates))
+		return
+	}
+	category := categories[idx]
+
+	apps, err := d.Client.ListDPIApps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing DPI applications", err.Error())
+		return
+	}
+
+	appIDs := []int64{}
+	for _, a := range apps {
+		if a.CategoryID == category.ID {
+			appIDs = append(appIDs, int64(a.ID))
+		}
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(category.ID))
+	data.MatchedName = types.StringValue(category.Name)
+	data.AppIDs, _ = types.ListValueFrom(ctx, types.Int64Type, appIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
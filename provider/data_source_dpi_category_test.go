@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDPICategoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDPICategoryDataSourceConfig("gaming"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_dpi_category.test", "id"),
+					resource.TestCheckResourceAttrSet("data.unifi_dpi_category.test", "matched_name"),
+					resource.TestCheckResourceAttrSet("data.unifi_dpi_category.test", "app_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDPICategoryDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_dpi_category" "test" {
+  name = %q
+}
+`, getProviderConfig(), name)
+}
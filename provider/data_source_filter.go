@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+// listFilter is the decoded `filter` attribute of the plural data sources.
+type listFilter struct {
+	nameRegex *regexp.Regexp
+	category  *string
+	vlanMin   *int64
+	vlanMax   *int64
+}
+
+// listFilterAttribute builds the `filter` attribute. categoryAttr names the
+// type-specific classification (e.g. purpose, group_type) and is omitted when
+// empty; the VLAN range is only offered for VLAN-backed objects.
+func listFilterAttribute(categoryAttr, categoryDescription string, withVLAN bool) schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only return objects whose name matches this regular expression.",
+		},
+	}
+	if categoryAttr != "" {
+		attributes[categoryAttr] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: categoryDescription,
+		}
+	}
+	if withVLAN {
+		attributes["vlan_min"] = schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Only return objects with a VLAN ID greater than or equal to this value.",
+			Validators: []validator.Int64{
+				validators.VLANID(),
+			},
+		}
+		attributes["vlan_max"] = schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Only return objects with a VLAN ID less than or equal to this value.",
+			Validators: []validator.Int64{
+				validators.VLANID(),
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Attributes:          attributes,
+		Optional:            true,
+		MarkdownDescription: "Restricts the returned objects. All set conditions must match.",
+	}
+}
+
+func newListFilter(filter types.Object, categoryAttr string) (*listFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := &listFilter{}
+	if filter.IsNull() || filter.IsUnknown() {
+		return f, diags
+	}
+
+	attrs := filter.Attributes()
+	if v, ok := attrs["name_regex"].(types.String); ok && !v.IsNull() {
+		re, err := regexp.Compile(v.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName("name_regex"), "Invalid name_regex", err.Error())
+			return nil, diags
+		}
+		f.nameRegex = re
+	}
+	if v, ok := attrs[categoryAttr].(types.String); ok && !v.IsNull() {
+		s := v.ValueString()
+		f.category = &s
+	}
+	if v, ok := attrs["vlan_min"].(types.Int64); ok && !v.IsNull() {
+		i := v.ValueInt64()
+		f.vlanMin = &i
+	}
+	if v, ok := attrs["vlan_max"].(types.Int64); ok && !v.IsNull() {
+		i := v.ValueInt64()
+		f.vlanMax = &i
+	}
+	return f, diags
+}
+
+// match reports whether an object passes the filter. Objects without a VLAN
+// never match a VLAN range.
+func (f *listFilter) match(name, category string, vlan *int) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	if f.category != nil && category != *f.category {
+		return false
+	}
+	if f.vlanMin != nil || f.vlanMax != nil {
+		if vlan == nil {
+			return false
+		}
+		if f.vlanMin != nil && int64(*vlan) < *f.vlanMin {
+			return false
+		}
+		if f.vlanMax != nil && int64(*vlan) > *f.vlanMax {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &firewallGroupsDataSource{}
+
+func NewFirewallGroupsDataSource() datasource.DataSource {
+	return &firewallGroupsDataSource{}
+}
+
+type firewallGroupsDataSource struct {
+	BaseDataSource
+}
+
+type firewallGroupsDataSourceModel struct {
+	Filter         types.Object `tfsdk:"filter"`
+	FirewallGroups types.List   `tfsdk:"firewall_groups"`
+}
+
+type firewallGroupDataModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	GroupType    types.String `tfsdk:"group_type"`
+	GroupMembers types.List   `tfsdk:"group_members"`
+}
+
+var firewallGroupDataAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"group_type":    types.StringType,
+	"group_members": types.ListType{ElemType: types.StringType},
+}
+
+func (d *firewallGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_groups"
+}
+
+func (d *firewallGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi firewall groups of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("group_type", "Only return groups of this type (address-group, ipv6-address-group, port-group).", false),
+			"firewall_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the firewall group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the firewall group.",
+						},
+						"group_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the firewall group.",
+						},
+						"group_members": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The members of the firewall group.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching firewall groups.",
+			},
+		},
+	}
+}
+
+func (d *firewallGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data firewallGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "group_type")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.Client.ListFirewallGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing firewall groups", err.Error())
+		return
+	}
+
+	items := []firewallGroupDataModel{}
+	for _, g := range groups {
+		if !filter.match(g.Name, g.GroupType, nil) {
+			continue
+		}
+		members, _ := types.ListValueFrom(ctx, types.StringType, g.GroupMembers)
+		items = append(items, firewallGroupDataModel{
+			ID:           types.StringValue(g.ID),
+			Name:         types.StringValue(g.Name),
+			GroupType:    types.StringValue(g.GroupType),
+			GroupMembers: members,
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: firewallGroupDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.FirewallGroups = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallGroupsDataSourceConfig("Test Firewall Groups CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_firewall_groups.test", "firewall_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unifi_firewall_groups.test", "firewall_groups.0.id", "unifi_firewall_group.test", "id"),
+					resource.TestCheckResourceAttr("data.unifi_firewall_groups.test", "firewall_groups.0.group_members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallGroupsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "unifi_firewall_group" "test" {
+  name          = %[2]q
+  group_type    = "address-group"
+  group_members = ["10.0.0.1", "10.0.0.2"]
+}
+
+data "unifi_firewall_groups" "test" {
+  filter = {
+    name_regex = "^%[2]s$"
+    group_type = "address-group"
+  }
+
+  depends_on = [unifi_firewall_group.test]
+}
+`, getProviderConfig(), name)
+}
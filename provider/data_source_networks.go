@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &networksDataSource{}
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+type networksDataSource struct {
+	BaseDataSource
+}
+
+type networksDataSourceModel struct {
+	Filter   types.Object `tfsdk:"filter"`
+	Networks types.List   `tfsdk:"networks"`
+}
+
+type networkDataModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Purpose types.String `tfsdk:"purpose"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	VlanID  types.Int64  `tfsdk:"vlan_id"`
+	Subnet  types.String `tfsdk:"subnet"`
+}
+
+var networkDataAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"name":    types.StringType,
+	"purpose": types.StringType,
+	"enabled": types.BoolType,
+	"vlan_id": types.Int64Type,
+	"subnet":  types.StringType,
+}
+
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi networks of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("purpose", "Only return networks with this purpose (e.g., corporate, guest, wan, vlan-only).", true),
+			"networks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the network.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the network.",
+						},
+						"purpose": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The purpose of the network.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the network is enabled.",
+						},
+						"vlan_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The VLAN ID of the network.",
+						},
+						"subnet": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subnet of the network.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching networks.",
+			},
+		},
+	}
+}
+
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data networksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "purpose")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networks, err := d.Client.ListNetworks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing networks", err.Error())
+		return
+	}
+
+	items := []networkDataModel{}
+	for _, n := range networks {
+		if !filter.match(n.Name, n.Purpose, n.VLAN) {
+			continue
+		}
+		items = append(items, networkDataModel{
+			ID:      types.StringValue(n.ID),
+			Name:    types.StringValue(n.Name),
+			Purpose: types.StringValue(n.Purpose),
+			Enabled: utils.BoolValue(n.Enabled),
+			VlanID:  utils.Int64Value(n.VLAN),
+			Subnet:  utils.StringToValue(n.IPSubnet),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networkDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Networks = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworksDataSourceConfig("Test Networks CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_networks.test", "networks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unifi_networks.test", "networks.0.id", "unifi_network.test", "id"),
+					resource.TestCheckResourceAttr("data.unifi_networks.test", "networks.0.vlan_id", "3210"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworksDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "unifi_network" "test" {
+  name    = %[2]q
+  purpose = "corporate"
+  vlan_id = 3210
+  subnet  = "10.32.10.1/24"
+}
+
+data "unifi_networks" "test" {
+  filter = {
+    name_regex = "^%[2]s$"
+    purpose    = "corporate"
+    vlan_min   = 3200
+    vlan_max   = 3220
+  }
+
+  depends_on = [unifi_network.test]
+}
+`, getProviderConfig(), name)
+}
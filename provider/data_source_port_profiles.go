@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &portProfilesDataSource{}
+
+func NewPortProfilesDataSource() datasource.DataSource {
+	return &portProfilesDataSource{}
+}
+
+type portProfilesDataSource struct {
+	BaseDataSource
+}
+
+type portProfilesDataSourceModel struct {
+	Filter       types.Object `tfsdk:"filter"`
+	PortProfiles types.List   `tfsdk:"port_profiles"`
+}
+
+type portProfileDataModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	NativeNetworkID  types.String `tfsdk:"native_network_id"`
+	TaggedNetworkIDs types.List   `tfsdk:"tagged_network_ids"`
+	Forward          types.String `tfsdk:"forward"`
+}
+
+var portProfileDataAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"native_network_id":  types.StringType,
+	"tagged_network_ids": types.ListType{ElemType: types.StringType},
+	"forward":            types.StringType,
+}
+
+func (d *portProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port_profiles"
+}
+
+func (d *portProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi switch port profiles of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("forward", "Only return port profiles with this forwarding mode (e.g., all, native, customize, disabled).", false),
+			"port_profiles": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the port profile.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the port profile.",
+						},
+						"native_network_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the native network.",
+						},
+						"tagged_network_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The IDs of the tagged networks.",
+						},
+						"forward": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The forwarding mode of the port profile.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching port profiles.",
+			},
+		},
+	}
+}
+
+func (d *portProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data portProfilesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "forward")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := d.Client.ListPortProfiles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing port profiles", err.Error())
+		return
+	}
+
+	items := []portProfileDataModel{}
+	for _, p := range profiles {
+		if !filter.match(p.Name, p.Forward, nil) {
+			continue
+		}
+		taggedIDs, _ := types.ListValueFrom(ctx, types.StringType, p.TaggedNetworkconfIDs)
+		items = append(items, portProfileDataModel{
+			ID:               types.StringValue(p.ID),
+			Name:             types.StringValue(p.Name),
+			NativeNetworkID:  types.StringValue(p.NativeNetworkconfID),
+			TaggedNetworkIDs: taggedIDs,
+			Forward:          types.StringValue(p.Forward),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: portProfileDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.PortProfiles = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &radiusProfilesDataSource{}
+
+func NewRADIUSProfilesDataSource() datasource.DataSource {
+	return &radiusProfilesDataSource{}
+}
+
+type radiusProfilesDataSource struct {
+	BaseDataSource
+}
+
+type radiusProfilesDataSourceModel struct {
+	Filter         types.Object `tfsdk:"filter"`
+	RADIUSProfiles types.List   `tfsdk:"radius_profiles"`
+}
+
+type radiusProfileDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	AuthServers types.List   `tfsdk:"auth_servers"`
+}
+
+var radiusServerDataAttrTypes = map[string]attr.Type{
+	"ip":     types.StringType,
+	"port":   types.Int64Type,
+	"secret": types.StringType,
+}
+
+var radiusProfileDataAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"name":         types.StringType,
+	"auth_servers": types.ListType{ElemType: types.ObjectType{AttrTypes: radiusServerDataAttrTypes}},
+}
+
+func (d *radiusProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_profiles"
+}
+
+func (d *radiusProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi RADIUS profiles of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("", "", false),
+			"radius_profiles": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the RADIUS profile.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the RADIUS profile.",
+						},
+						"auth_servers": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"ip": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The IP address of the authentication server.",
+									},
+									"port": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The port of the authentication server.",
+									},
+									"secret": schema.StringAttribute{
+										Computed:            true,
+										Sensitive:           true,
+										MarkdownDescription: "The shared secret of the authentication server.",
+									},
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "The authentication servers of the RADIUS profile.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching RADIUS profiles.",
+			},
+		},
+	}
+}
+
+func (d *radiusProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data radiusProfilesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := d.Client.ListRADIUSProfiles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing RADIUS profiles", err.Error())
+		return
+	}
+
+	items := []radiusProfileDataModel{}
+	for _, p := range profiles {
+		if !filter.match(p.Name, "", nil) {
+			continue
+		}
+
+		servers := make([]radiusServerModel, len(p.AuthServers))
+		for i, s := range p.AuthServers {
+			servers[i] = radiusServerModel{
+				IP:     types.StringValue(s.IP),
+				Port:   utils.Int64Value(s.Port),
+				Secret: types.StringValue(s.XSecret),
+			}
+		}
+		authServers, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: radiusServerDataAttrTypes}, servers)
+
+		items = append(items, radiusProfileDataModel{
+			ID:          types.StringValue(p.ID),
+			Name:        types.StringValue(p.Name),
+			AuthServers: authServers,
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: radiusProfileDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.RADIUSProfiles = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ datasource.DataSource = &regionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+type regionsDataSource struct {
+	BaseDataSource
+}
+
+type regionsDataSourceModel struct {
+	Regions types.List `tfsdk:"regions"`
+}
+
+type regionDataModel struct {
+	Code        types.String `tfsdk:"code"`
+	NumericCode types.String `tfsdk:"numeric_code"`
+	Name        types.String `tfsdk:"name"`
+}
+
+var regionDataAttrTypes = map[string]attr.Type{
+	"code":         types.StringType,
+	"numeric_code": types.StringType,
+	"name":         types.StringType,
+}
+
+func (d *regionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the regions supported by the UniFi controller, for use in geo-blocking `regions` attributes.",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ISO 3166-1 alpha-2 code of the region.",
+						},
+						"numeric_code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ISO 3166-1 numeric code of the region.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the region.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The supported regions, sorted by code.",
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.Client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing regions", err.Error())
+		return
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Key < regions[j].Key
+	})
+
+	items := make([]regionDataModel, len(regions))
+	for i, r := range regions {
+		name := r.Name
+		if name == "" {
+			name = validators.RegionNames[r.Key]
+		}
+		items[i] = regionDataModel{
+			Code:        types.StringValue(r.Key),
+			NumericCode: types.StringValue(r.Code),
+			Name:        types.StringValue(name),
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Regions = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_regions.test", "regions.#"),
+					resource.TestCheckResourceAttrSet("data.unifi_regions.test", "regions.0.code"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegionsDataSourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "unifi_regions" "test" {}
+`, getProviderConfig())
+}
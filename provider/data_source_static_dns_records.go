@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &staticDNSRecordsDataSource{}
+
+func NewStaticDNSRecordsDataSource() datasource.DataSource {
+	return &staticDNSRecordsDataSource{}
+}
+
+type staticDNSRecordsDataSource struct {
+	BaseDataSource
+}
+
+type staticDNSRecordsDataSourceModel struct {
+	Filter  types.Object `tfsdk:"filter"`
+	Records types.List   `tfsdk:"records"`
+}
+
+type staticDNSRecordDataModel struct {
+	ID         types.String `tfsdk:"id"`
+	Key        types.String `tfsdk:"key"`
+	Value      types.String `tfsdk:"value"`
+	RecordType types.String `tfsdk:"record_type"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+}
+
+var staticDNSRecordDataAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"key":         types.StringType,
+	"value":       types.StringType,
+	"record_type": types.StringType,
+	"enabled":     types.BoolType,
+	"ttl":         types.Int64Type,
+}
+
+func (d *staticDNSRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static_dns_records"
+}
+
+func (d *staticDNSRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi static DNS records of the site. The name filter matches the record hostname.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("record_type", "Only return records of this type (e.g., A, AAAA, CNAME).", false),
+			"records": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the DNS record.",
+						},
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname for the DNS record.",
+						},
+						"value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value (IP or hostname) for the DNS record.",
+						},
+						"record_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the DNS record.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the DNS record is enabled.",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The TTL for the DNS record.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching DNS records.",
+			},
+		},
+	}
+}
+
+func (d *staticDNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data staticDNSRecordsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "record_type")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.Client.ListStaticDNS(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing static DNS records", err.Error())
+		return
+	}
+
+	items := []staticDNSRecordDataModel{}
+	for _, r := range records {
+		if !filter.match(r.Key, r.RecordType, nil) {
+			continue
+		}
+		items = append(items, staticDNSRecordDataModel{
+			ID:         types.StringValue(r.ID),
+			Key:        types.StringValue(r.Key),
+			Value:      types.StringValue(r.Value),
+			RecordType: types.StringValue(r.RecordType),
+			Enabled:    utils.BoolValue(r.Enabled),
+			TTL:        utils.Int64Value(r.TTL),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: staticDNSRecordDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Records = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
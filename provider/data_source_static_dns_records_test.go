@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStaticDNSRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticDNSRecordsDataSourceConfig("records-ci.home.local", "192.168.1.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_static_dns_records.test", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.unifi_static_dns_records.test", "records.0.value", "192.168.1.11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStaticDNSRecordsDataSourceConfig(key, value string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "unifi_static_dns" "test" {
+  key         = %[2]q
+  value       = %[3]q
+  record_type = "A"
+}
+
+data "unifi_static_dns_records" "test" {
+  filter = {
+    name_regex  = "^records-ci\\."
+    record_type = "A"
+  }
+
+  depends_on = [unifi_static_dns.test]
+}
+`, getProviderConfig(), key, value)
+}
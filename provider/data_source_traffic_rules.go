@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &trafficRulesDataSource{}
+
+func NewTrafficRulesDataSource() datasource.DataSource {
+	return &trafficRulesDataSource{}
+}
+
+type trafficRulesDataSource struct {
+	BaseDataSource
+}
+
+type trafficRulesDataSourceModel struct {
+	Filter       types.Object `tfsdk:"filter"`
+	TrafficRules types.List   `tfsdk:"traffic_rules"`
+}
+
+type trafficRuleDataModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Action         types.String `tfsdk:"action"`
+	MatchingTarget types.String `tfsdk:"matching_target"`
+	NetworkID      types.String `tfsdk:"network_id"`
+}
+
+var trafficRuleDataAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"name":            types.StringType,
+	"description":     types.StringType,
+	"enabled":         types.BoolType,
+	"action":          types.StringType,
+	"matching_target": types.StringType,
+	"network_id":      types.StringType,
+}
+
+func (d *trafficRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_rules"
+}
+
+func (d *trafficRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi traffic management rules of the site. " +
+			"The name filter falls back to the description for rules the controller stores without a name.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("matching_target", "Only return rules with this matching target (e.g., INTERNET, IP, DOMAIN, APP).", false),
+			"traffic_rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the traffic rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the traffic rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the traffic rule.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the traffic rule is enabled.",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The action for the traffic rule.",
+						},
+						"matching_target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The matching target for the traffic rule.",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the network the rule applies to.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching traffic rules.",
+			},
+		},
+	}
+}
+
+func (d *trafficRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data trafficRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "matching_target")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := d.Client.ListTrafficRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing traffic rules", err.Error())
+		return
+	}
+
+	items := []trafficRuleDataModel{}
+	for _, r := range rules {
+		name := r.Name
+		if name == "" {
+			name = r.Description
+		}
+		if !filter.match(name, r.MatchingTarget, nil) {
+			continue
+		}
+		items = append(items, trafficRuleDataModel{
+			ID:             types.StringValue(r.ID),
+			Name:           utils.StringToValue(r.Name),
+			Description:    utils.StringToValue(r.Description),
+			Enabled:        utils.BoolValue(r.Enabled),
+			Action:         types.StringValue(r.Action),
+			MatchingTarget: types.StringValue(r.MatchingTarget),
+			NetworkID:      utils.StringToValue(r.NetworkID),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: trafficRuleDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.TrafficRules = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &userGroupsDataSource{}
+
+func NewUserGroupsDataSource() datasource.DataSource {
+	return &userGroupsDataSource{}
+}
+
+type userGroupsDataSource struct {
+	BaseDataSource
+}
+
+type userGroupsDataSourceModel struct {
+	Filter     types.Object `tfsdk:"filter"`
+	UserGroups types.List   `tfsdk:"user_groups"`
+}
+
+type userGroupDataModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DownloadLimit types.Int64  `tfsdk:"download_limit"`
+	UploadLimit   types.Int64  `tfsdk:"upload_limit"`
+}
+
+var userGroupDataAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"download_limit": types.Int64Type,
+	"upload_limit":   types.Int64Type,
+}
+
+func (d *userGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_groups"
+}
+
+func (d *userGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi user groups of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("", "", false),
+			"user_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the user group.",
+						},
+						"download_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The download rate limit in Kbps.",
+						},
+						"upload_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The upload rate limit in Kbps.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching user groups.",
+			},
+		},
+	}
+}
+
+func (d *userGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.Client.ListUserGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing user groups", err.Error())
+		return
+	}
+
+	items := []userGroupDataModel{}
+	for _, g := range groups {
+		if !filter.match(g.Name, "", nil) {
+			continue
+		}
+		items = append(items, userGroupDataModel{
+			ID:            types.StringValue(g.ID),
+			Name:          types.StringValue(g.Name),
+			DownloadLimit: utils.Int64Value(g.QosRateMaxDown),
+			UploadLimit:   utils.Int64Value(g.QosRateMaxUp),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userGroupDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.UserGroups = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
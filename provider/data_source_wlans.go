@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ datasource.DataSource = &wlansDataSource{}
+
+func NewWLANsDataSource() datasource.DataSource {
+	return &wlansDataSource{}
+}
+
+type wlansDataSource struct {
+	BaseDataSource
+}
+
+type wlansDataSourceModel struct {
+	Filter types.Object `tfsdk:"filter"`
+	WLANs  types.List   `tfsdk:"wlans"`
+}
+
+type wlanDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Security    types.String `tfsdk:"security"`
+	NetworkID   types.String `tfsdk:"network_id"`
+	APGroupIDs  types.List   `tfsdk:"ap_group_ids"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+}
+
+var wlanDataAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"enabled":       types.BoolType,
+	"security":      types.StringType,
+	"network_id":    types.StringType,
+	"ap_group_ids":  types.ListType{ElemType: types.StringType},
+	"user_group_id": types.StringType,
+}
+
+func (d *wlansDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wlans"
+}
+
+func (d *wlansDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the UniFi wireless networks (SSIDs) of the site.",
+		Attributes: map[string]schema.Attribute{
+			"filter": listFilterAttribute("security", "Only return WLANs with this security protocol (e.g., wpapsk, wpaeap, open).", false),
+			"wlans": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the WLAN.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SSID of the wireless network.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the WLAN is enabled.",
+						},
+						"security": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The security protocol for the wireless network.",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the network configuration.",
+						},
+						"ap_group_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The IDs of the AP groups that broadcast this SSID.",
+						},
+						"user_group_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user group for the WLAN.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The matching WLANs.",
+			},
+		},
+	}
+}
+
+func (d *wlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data wlansDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.Filter, "security")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wlans, err := d.Client.ListWLANs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing WLANs", err.Error())
+		return
+	}
+
+	items := []wlanDataModel{}
+	for _, w := range wlans {
+		if !filter.match(w.Name, w.Security, nil) {
+			continue
+		}
+		apGroupIDs, _ := types.ListValueFrom(ctx, types.StringType, w.APGroupIDs)
+		items = append(items, wlanDataModel{
+			ID:          types.StringValue(w.ID),
+			Name:        types.StringValue(w.Name),
+			Enabled:     utils.BoolValue(w.Enabled),
+			Security:    types.StringValue(w.Security),
+			NetworkID:   types.StringValue(w.NetworkConfID),
+			APGroupIDs:  apGroupIDs,
+			UserGroupID: types.StringValue(w.Usergroup),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: wlanDataAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.WLANs = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &wlanPassphraseEphemeralResource{}
+
+func NewWLANPassphraseEphemeralResource() ephemeral.EphemeralResource {
+	return &wlanPassphraseEphemeralResource{}
+}
+
+// WPA passphrases are 8 to 63 printable ASCII characters. The generated ones
+// avoid look-alike characters so they can be typed from a printed card.
+const (
+	minPassphraseLength     = 8
+	maxPassphraseLength     = 63
+	defaultPassphraseLength = 24
+
+	passphraseAlphanumeric = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	passphraseSpecial      = "!#%+-=?@_~"
+)
+
+type wlanPassphraseEphemeralResource struct{}
+
+type wlanPassphraseEphemeralResourceModel struct {
+	Length     types.Int64  `tfsdk:"length"`
+	Special    types.Bool   `tfsdk:"special"`
+	Passphrase types.String `tfsdk:"passphrase"`
+}
+
+func (r *wlanPassphraseEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wlan_passphrase"
+}
+
+func (r *wlanPassphraseEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a random WPA passphrase that is never stored in state. Pass it to `unifi_wlan.passphrase_wo`.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The length of the passphrase, between 8 and 63. Defaults to 24.",
+				Validators: []validator.Int64{
+					int64validator.Between(minPassphraseLength, maxPassphraseLength),
+				},
+			},
+			"special": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include punctuation characters. Defaults to false.",
+			},
+			"passphrase": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The generated passphrase.",
+			},
+		},
+	}
+}
+
+func (r *wlanPassphraseEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data wlanPassphraseEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(defaultPassphraseLength)
+	if !data.Length.IsNull() {
+		length = data.Length.ValueInt64()
+	}
+	charset := passphraseAlphanumeric
+	if data.Special.ValueBool() {
+		charset += passphraseSpecial
+	}
+
+	passphrase, err := randomString(int(length), charset)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating passphrase", err.Error())
+		return
+	}
+
+	data.Passphrase = types.StringValue(passphrase)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// randomString returns n characters drawn uniformly from charset.
+func randomString(n int, charset string) (string, error) {
+	limit := big.NewInt(int64(len(charset)))
+	b := make([]byte, n)
+	for i := range b {
+		idx, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		b[i] = charset[idx.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccWLANPassphraseEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"unifi": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":  echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANPassphraseEphemeralResourceConfig(32, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("passphrase"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-zA-Z0-9]{32}$`))),
+				},
+			},
+			{
+				Config:      testAccWLANPassphraseEphemeralResourceConfig(64, false),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+func testAccWLANPassphraseEphemeralResourceConfig(length int, special bool) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "unifi_wlan_passphrase" "test" {
+  length  = %[2]d
+  special = %[3]t
+}
+
+provider "echo" {
+  data = ephemeral.unifi_wlan_passphrase.test
+}
+
+resource "echo" "test" {}
+`, getProviderConfig(), length, special)
+}
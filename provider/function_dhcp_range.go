@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &dhcpRangeFunction{}
+
+func NewDHCPRangeFunction() function.Function {
+	return &dhcpRangeFunction{}
+}
+
+type dhcpRangeFunction struct{}
+
+type dhcpRangeModel struct {
+	Start types.String `tfsdk:"start"`
+	Stop  types.String `tfsdk:"stop"`
+}
+
+var dhcpRangeAttrTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"stop":  types.StringType,
+}
+
+func (f *dhcpRangeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dhcp_range"
+}
+
+func (f *dhcpRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "DHCP range within a subnet",
+		MarkdownDescription: "Returns an object with the `start` and `stop` addresses of a DHCP range within an IPv4 subnet. " +
+			"Offsets count from the network address; negative offsets count back from the end of the subnet as in `cidrhost`, so `-2` is the last usable address. " +
+			"For example, `dhcp_range(\"10.0.20.0/24\", 100, -2)` returns `10.0.20.100` to `10.0.20.254`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The subnet in CIDR notation. The gateway form used by `unifi_network.subnet` is accepted.",
+			},
+			function.Int64Parameter{
+				Name:                "start_offset",
+				MarkdownDescription: "The offset of the first address of the range.",
+			},
+			function.Int64Parameter{
+				Name:                "end_offset",
+				MarkdownDescription: "The offset of the last address of the range.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dhcpRangeAttrTypes,
+		},
+	}
+}
+
+func (f *dhcpRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var startOffset, endOffset int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &startOffset, &endOffset))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid IPv4 network in CIDR notation", cidr))
+		return
+	}
+	start, err := ipv4Host(prefix, startOffset)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	stop, err := ipv4Host(prefix, endOffset)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	if stop.Less(start) {
+		resp.Error = function.NewFuncError(fmt.Sprintf("the range %s-%s starts after it ends", start, stop))
+		return
+	}
+
+	result, diags := types.ObjectValueFrom(ctx, dhcpRangeAttrTypes, dhcpRangeModel{
+		Start: types.StringValue(start.String()),
+		Stop:  types.StringValue(stop.String()),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ipv4Host returns the usable host address at offset within prefix, counting
+// back from the end of the subnet for negative offsets.
+func ipv4Host(prefix netip.Prefix, offset int64) (netip.Addr, error) {
+	size := int64(1) << (32 - prefix.Bits())
+	index := offset
+	if index < 0 {
+		index += size
+	}
+	if index <= 0 || index >= size-1 {
+		return netip.Addr{}, fmt.Errorf("offset %d is not a usable host address of %s", offset, prefix.Masked())
+	}
+	network := prefix.Masked().Addr().As4()
+	return uint32ToAddr(binary.BigEndian.Uint32(network[:]) + uint32(index)), nil
+}
+
+func uint32ToAddr(n uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], n)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDHCPRangeFunction(t *testing.T) {
+	tests := map[string]struct {
+		cidr       string
+		start, end int64
+		wantStart  string
+		wantStop   string
+		wantErr    bool
+	}{
+		"offsets":         {cidr: "10.0.20.0/24", start: 100, end: 199, wantStart: "10.0.20.100", wantStop: "10.0.20.199"},
+		"negative end":    {cidr: "10.0.20.0/24", start: 100, end: -2, wantStart: "10.0.20.100", wantStop: "10.0.20.254"},
+		"gateway form":    {cidr: "192.168.1.1/24", start: 6, end: 254, wantStart: "192.168.1.6", wantStop: "192.168.1.254"},
+		"larger subnet":   {cidr: "10.1.0.0/22", start: 256, end: -2, wantStart: "10.1.1.0", wantStop: "10.1.3.254"},
+		"single address":  {cidr: "10.0.0.0/24", start: 10, end: 10, wantStart: "10.0.0.10", wantStop: "10.0.0.10"},
+		"network address": {cidr: "10.0.20.0/24", start: 0, end: 10, wantErr: true},
+		"broadcast":       {cidr: "10.0.20.0/24", start: 10, end: -1, wantErr: true},
+		"outside subnet":  {cidr: "10.0.20.0/24", start: 10, end: 300, wantErr: true},
+		"reversed":        {cidr: "10.0.20.0/24", start: 200, end: 100, wantErr: true},
+		"ipv6":            {cidr: "2001:db8::/64", start: 10, end: 20, wantErr: true},
+		"invalid":         {cidr: "10.0.20/24", start: 10, end: 20, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(t, NewDHCPRangeFunction(), types.StringValue(tc.cidr), types.Int64Value(tc.start), types.Int64Value(tc.end))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			want := types.ObjectValueMust(dhcpRangeAttrTypes, map[string]attr.Value{
+				"start": types.StringValue(tc.wantStart),
+				"stop":  types.StringValue(tc.wantStop),
+			})
+			if !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
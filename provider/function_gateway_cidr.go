@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &gatewayCIDRFunction{}
+
+func NewGatewayCIDRFunction() function.Function {
+	return &gatewayCIDRFunction{}
+}
+
+type gatewayCIDRFunction struct{}
+
+func (f *gatewayCIDRFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gateway_cidr"
+}
+
+func (f *gatewayCIDRFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Gateway address of a subnet",
+		MarkdownDescription: "Returns the first host address of a subnet with its prefix length, the form `unifi_network.subnet` expects. For example, `10.0.20.0/24` becomes `10.0.20.1/24`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The subnet in CIDR notation.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *gatewayCIDRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	gateway, err := gatewayCIDR(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, gateway))
+}
+
+func gatewayCIDR(cidr string) (string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid network in CIDR notation", cidr)
+	}
+	if prefix.Addr().BitLen()-prefix.Bits() < 2 {
+		return "", fmt.Errorf("%q has no room for a gateway and clients", cidr)
+	}
+	return netip.PrefixFrom(prefix.Masked().Addr().Next(), prefix.Bits()).String(), nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGatewayCIDRFunction(t *testing.T) {
+	tests := map[string]struct {
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		"network":      {cidr: "10.0.20.0/24", want: "10.0.20.1/24"},
+		"gateway form": {cidr: "192.168.1.1/24", want: "192.168.1.1/24"},
+		"host bits":    {cidr: "172.16.5.77/16", want: "172.16.0.1/16"},
+		"ipv6":         {cidr: "2001:db8::/64", want: "2001:db8::1/64"},
+		"smallest":     {cidr: "10.0.0.0/30", want: "10.0.0.1/30"},
+		"too small":    {cidr: "10.0.0.0/31", wantErr: true},
+		"invalid":      {cidr: "10.0.0/24", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(t, NewGatewayCIDRFunction(), types.StringValue(tc.cidr))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(types.StringValue(tc.want)) {
+				t.Errorf("got %s, want %q", got, tc.want)
+			}
+		})
+	}
+}
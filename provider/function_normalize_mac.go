@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ function.Function = &normalizeMACFunction{}
+
+func NewNormalizeMACFunction() function.Function {
+	return &normalizeMACFunction{}
+}
+
+type normalizeMACFunction struct{}
+
+func (f *normalizeMACFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_mac"
+}
+
+func (f *normalizeMACFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a MAC address",
+		MarkdownDescription: "Returns a MAC address in the lowercase, colon-separated form the controller uses. For example, `AA-BB-CC-DD-EE-FF` becomes `aa:bb:cc:dd:ee:ff`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac",
+				MarkdownDescription: "The MAC address, with colon or hyphen separators.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeMACFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mac string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mac))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validators.CheckMACAddress(mac); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, utils.NormalizeMAC(mac)))
+}
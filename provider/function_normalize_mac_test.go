@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeMACFunction(t *testing.T) {
+	tests := map[string]struct {
+		mac     string
+		want    string
+		wantErr bool
+	}{
+		"normalized": {mac: "aa:bb:cc:dd:ee:ff", want: "aa:bb:cc:dd:ee:ff"},
+		"uppercase":  {mac: "AA:BB:CC:DD:EE:FF", want: "aa:bb:cc:dd:ee:ff"},
+		"hyphens":    {mac: "AA-bb-CC-dd-EE-ff", want: "aa:bb:cc:dd:ee:ff"},
+		"mixed":      {mac: "aa:bb-cc:dd-ee:ff", wantErr: true},
+		"short":      {mac: "aa:bb:cc:dd:ee", wantErr: true},
+		"bare":       {mac: "aabbccddeeff", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(t, NewNormalizeMACFunction(), types.StringValue(tc.mac))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(types.StringValue(tc.want)) {
+				t.Errorf("got %s, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &vlanSubnetFunction{}
+
+func NewVLANSubnetFunction() function.Function {
+	return &vlanSubnetFunction{}
+}
+
+type vlanSubnetFunction struct{}
+
+func (f *vlanSubnetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vlan_subnet"
+}
+
+func (f *vlanSubnetFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Per-VLAN /24 subnet within a base network",
+		MarkdownDescription: "Returns the /24 subnet numbered after a VLAN ID within an IPv4 base network. " +
+			"For example, `vlan_subnet(\"10.0.0.0/16\", 20)` returns `10.0.20.0/24`. Combine it with `gateway_cidr` to fill `unifi_network.subnet`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "The base network in CIDR notation, with a prefix length of at most /24.",
+			},
+			function.Int64Parameter{
+				Name:                "vlan",
+				MarkdownDescription: "The VLAN ID. It must fit in the bits between the base prefix and /24.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *vlanSubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var base string
+	var vlan int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &base, &vlan))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(base)
+	if err != nil || !prefix.Addr().Is4() || prefix.Bits() > 24 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IPv4 network in CIDR notation with a prefix length of at most /24", base))
+		return
+	}
+	if vlan < 0 || vlan >= int64(1)<<(24-prefix.Bits()) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("VLAN %d does not fit within %s; use a shorter base prefix", vlan, prefix.Masked()))
+		return
+	}
+
+	network := prefix.Masked().Addr().As4()
+	subnet := netip.PrefixFrom(uint32ToAddr(binary.BigEndian.Uint32(network[:])+uint32(vlan)<<8), 24)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subnet.String()))
+}
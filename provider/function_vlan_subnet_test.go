@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVLANSubnetFunction(t *testing.T) {
+	tests := map[string]struct {
+		base    string
+		vlan    int64
+		want    string
+		wantErr bool
+	}{
+		"slash 16":        {base: "10.0.0.0/16", vlan: 20, want: "10.0.20.0/24"},
+		"slash 12":        {base: "172.16.0.0/12", vlan: 300, want: "172.17.44.0/24"},
+		"slash 8":         {base: "10.0.0.0/8", vlan: 4009, want: "10.15.169.0/24"},
+		"host bits":       {base: "192.168.7.1/16", vlan: 10, want: "192.168.10.0/24"},
+		"slash 24":        {base: "192.168.1.0/24", vlan: 0, want: "192.168.1.0/24"},
+		"does not fit":    {base: "10.0.0.0/16", vlan: 256, wantErr: true},
+		"negative":        {base: "10.0.0.0/16", vlan: -1, wantErr: true},
+		"prefix too long": {base: "10.0.0.0/25", vlan: 0, wantErr: true},
+		"ipv6":            {base: "2001:db8::/48", vlan: 1, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(t, NewVLANSubnetFunction(), types.StringValue(tc.base), types.Int64Value(tc.vlan))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(types.StringValue(tc.want)) {
+				t.Errorf("got %s, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &wifiQRFunction{}
+
+func NewWiFiQRFunction() function.Function {
+	return &wifiQRFunction{}
+}
+
+type wifiQRFunction struct{}
+
+// wifiQRAuth maps WLAN security settings to the authentication types of the
+// WIFI: QR code format.
+var wifiQRAuth = map[string]string{
+	"open":   "nopass",
+	"nopass": "nopass",
+	"wep":    "WEP",
+	"wpapsk": "WPA",
+	"wpa":    "WPA",
+}
+
+// wifiQREscaper escapes the characters with a special meaning in WIFI: QR
+// code fields.
+var wifiQREscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`)
+
+func (f *wifiQRFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "wifi_qr"
+}
+
+func (f *wifiQRFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Wi-Fi QR code payload",
+		MarkdownDescription: "Returns the `WIFI:` payload that phones recognise when scanned as a QR code, e.g. `WIFI:T:WPA;S:Home;P:secret;;`. " +
+			"Render it with any QR code generator.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ssid",
+				MarkdownDescription: "The SSID of the wireless network.",
+			},
+			function.StringParameter{
+				Name:                "security",
+				MarkdownDescription: "The security protocol, as in `unifi_wlan.security`: `open`, `wep` or `wpapsk`.",
+			},
+			function.StringParameter{
+				Name:                "passphrase",
+				MarkdownDescription: "The passphrase. It must be empty for open networks.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *wifiQRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ssid, security, passphrase string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ssid, &security, &passphrase))
+	if resp.Error != nil {
+		return
+	}
+
+	payload, argument, err := wifiQR(ssid, security, passphrase)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, payload))
+}
+
+// wifiQR builds the payload, or returns the index of the invalid argument.
+func wifiQR(ssid, security, passphrase string) (string, int64, error) {
+	if ssid == "" {
+		return "", 0, errors.New("ssid must not be empty")
+	}
+	auth, ok := wifiQRAuth[strings.ToLower(security)]
+	if !ok {
+		return "", 1, fmt.Errorf("security %q is not supported; use open, wep or wpapsk", security)
+	}
+
+	payload := "WIFI:T:" + auth + ";S:" + wifiQREscaper.Replace(ssid) + ";"
+	switch {
+	case auth == "nopass" && passphrase != "":
+		return "", 2, errors.New("open networks take no passphrase")
+	case auth != "nopass" && passphrase == "":
+		return "", 2, fmt.Errorf("passphrase must not be empty for %s networks", security)
+	case auth != "nopass":
+		payload += "P:" + wifiQREscaper.Replace(passphrase) + ";"
+	}
+	return payload + ";", 0, nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWiFiQRFunction(t *testing.T) {
+	tests := map[string]struct {
+		ssid, security, passphrase string
+		want                       string
+		wantErr                    bool
+	}{
+		"wpa":             {ssid: "Home", security: "wpapsk", passphrase: "secret123", want: "WIFI:T:WPA;S:Home;P:secret123;;"},
+		"wep":             {ssid: "Old", security: "WEP", passphrase: "abcde", want: "WIFI:T:WEP;S:Old;P:abcde;;"},
+		"open":            {ssid: "Guest", security: "open", want: "WIFI:T:nopass;S:Guest;;"},
+		"escaped":         {ssid: `My;Net:"1"`, security: "wpapsk", passphrase: `a\b,c`, want: `WIFI:T:WPA;S:My\;Net\:\"1\";P:a\\b\,c;;`},
+		"open passphrase": {ssid: "Guest", security: "open", passphrase: "secret123", wantErr: true},
+		"no passphrase":   {ssid: "Home", security: "wpapsk", wantErr: true},
+		"enterprise":      {ssid: "Corp", security: "wpaeap", passphrase: "secret123", wantErr: true},
+		"no ssid":         {security: "open", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(t, NewWiFiQRFunction(), types.StringValue(tc.ssid), types.StringValue(tc.security), types.StringValue(tc.passphrase))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(types.StringValue(tc.want)) {
+				t.Errorf("got %s, want %q", got, tc.want)
+			}
+		})
+	}
+}
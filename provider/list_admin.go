@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &adminListResource{}
+var _ list.ListResourceWithConfigure = &adminListResource{}
+
+func NewAdminListResource() list.ListResource {
+	return &adminListResource{}
+}
+
+type adminListResource struct {
+	adminResource
+}
+
+func (r *adminListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the administrators of the site.",
+	}
+}
+
+func (r *adminListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	admins, err := r.Client.ListAdmins(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing administrators", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, admins, func(a *client.Admin) (string, string) {
+		if a.Name != "" {
+			return a.ID, a.Name
+		}
+		return a.ID, a.Email
+	}, func(data *adminResourceModel, a *client.Admin) {
+		r.syncState(ctx, data, a)
+	})
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &apGroupListResource{}
+var _ list.ListResourceWithConfigure = &apGroupListResource{}
+
+func NewAPGroupListResource() list.ListResource {
+	return &apGroupListResource{}
+}
+
+type apGroupListResource struct {
+	apGroupResource
+}
+
+func (r *apGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the AP groups of the site.",
+	}
+}
+
+func (r *apGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	groups, err := r.Client.ListAPGroups(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing AP groups", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, groups, func(g *client.APGroup) (string, string) {
+		return g.ID, g.Name
+	}, func(data *apGroupResourceModel, g *client.APGroup) {
+		r.syncState(ctx, data, g)
+	})
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &contentFilterListResource{}
+var _ list.ListResourceWithConfigure = &contentFilterListResource{}
+
+func NewContentFilterListResource() list.ListResource {
+	return &contentFilterListResource{}
+}
+
+type contentFilterListResource struct {
+	contentFilterResource
+}
+
+func (r *contentFilterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the content filters of the site.",
+	}
+}
+
+func (r *contentFilterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	filters, err := r.Client.ListContentFilters(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing content filters", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, filters, func(f *client.ContentFilter) (string, string) {
+		return f.ID, f.Name
+	}, func(data *contentFilterResourceModel, f *client.ContentFilter) {
+		r.syncState(ctx, data, f)
+	})
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &firewallGroupListResource{}
+var _ list.ListResourceWithConfigure = &firewallGroupListResource{}
+
+func NewFirewallGroupListResource() list.ListResource {
+	return &firewallGroupListResource{}
+}
+
+type firewallGroupListResource struct {
+	firewallGroupResource
+}
+
+func (r *firewallGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the firewall groups of the site.",
+	}
+}
+
+func (r *firewallGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	groups, err := r.Client.ListFirewallGroups(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing firewall groups", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, groups, func(g *client.FirewallGroup) (string, string) {
+		return g.ID, g.Name
+	}, func(data *firewallGroupResourceModel, g *client.FirewallGroup) {
+		r.syncState(ctx, data, g)
+	})
+}
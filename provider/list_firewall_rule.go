@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &firewallRuleListResource{}
+var _ list.ListResourceWithConfigure = &firewallRuleListResource{}
+
+func NewFirewallRuleListResource() list.ListResource {
+	return &firewallRuleListResource{}
+}
+
+type firewallRuleListResource struct {
+	firewallRuleResource
+}
+
+func (r *firewallRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the firewall rules of the site.",
+	}
+}
+
+func (r *firewallRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	rules, err := r.Client.ListFirewallRules(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing firewall rules", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, rules, func(rule *client.FirewallRule) (string, string) {
+		return rule.ID, rule.Name
+	}, r.syncState)
+}
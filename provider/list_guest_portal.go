@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &guestPortalListResource{}
+var _ list.ListResourceWithConfigure = &guestPortalListResource{}
+
+func NewGuestPortalListResource() list.ListResource {
+	return &guestPortalListResource{}
+}
+
+type guestPortalListResource struct {
+	guestPortalResource
+}
+
+func (r *guestPortalListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the guest portal settings of the site, which always exist.",
+	}
+}
+
+func (r *guestPortalListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	setting, err := r.Client.GetSettingGuestAccess(ctx)
+	if err != nil {
+		stream.Results = listError("Error reading guest portal", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, []client.SettingGuestAccess{*setting}, func(s *client.SettingGuestAccess) (string, string) {
+		return s.ID, "Guest portal"
+	}, func(data *guestPortalResourceModel, s *client.SettingGuestAccess) {
+		r.syncState(ctx, data, s)
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGuestPortalListResource(t *testing.T) {
+	byName := queryfilter.ByDisplayName(knownvalue.StringExact("Guest portal"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuestPortalResourceConfig("Query Portal", 720),
+			},
+			{
+				// The setting is a singleton, so the list has exactly one result.
+				Query:  true,
+				Config: testAccGuestPortalListConfig(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("unifi_guest_portal.all", 1),
+					querycheck.ExpectResourceKnownValues("unifi_guest_portal.all", byName, []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("title"), KnownValue: knownvalue.StringExact("Query Portal")},
+						{Path: tfjsonpath.New("expire"), KnownValue: knownvalue.Int64Exact(720)},
+						{Path: tfjsonpath.New("voucher_enabled"), KnownValue: knownvalue.Bool(true)},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccGuestPortalListConfig() string {
+	return fmt.Sprintf(`
+%s
+
+list "unifi_guest_portal" "all" {
+  provider         = unifi
+  include_resource = true
+}
+`, getProviderConfig())
+}
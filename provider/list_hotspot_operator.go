@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &hotspotOperatorListResource{}
+var _ list.ListResourceWithConfigure = &hotspotOperatorListResource{}
+
+func NewHotspotOperatorListResource() list.ListResource {
+	return &hotspotOperatorListResource{}
+}
+
+type hotspotOperatorListResource struct {
+	hotspotOperatorResource
+}
+
+func (r *hotspotOperatorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the hotspot operators of the site.",
+	}
+}
+
+func (r *hotspotOperatorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	operators, err := r.Client.ListHotspotOperators(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing hotspot operators", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, operators, func(o *client.HotspotOperator) (string, string) {
+		return o.ID, o.Name
+	}, r.syncState)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &hotspotVoucherListResource{}
+var _ list.ListResourceWithConfigure = &hotspotVoucherListResource{}
+
+func NewHotspotVoucherListResource() list.ListResource {
+	return &hotspotVoucherListResource{}
+}
+
+type hotspotVoucherListResource struct {
+	hotspotVoucherResource
+}
+
+func (r *hotspotVoucherListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the hotspot voucher batches of the site, grouping vouchers created together with the same settings.",
+	}
+}
+
+func (r *hotspotVoucherListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	vouchers, err := r.Client.ListVouchers(ctx, 0)
+	if err != nil {
+		stream.Results = listError("Error listing hotspot vouchers", err)
+		return
+	}
+
+	batches := groupVoucherBatches(vouchers)
+
+	stream.Results = listResults(ctx, req, batches, func(b *[]client.Voucher) (string, string) {
+		if note := (*b)[0].Note; note != "" {
+			return (*b)[0].ID, note
+		}
+		return (*b)[0].ID, "Vouchers created " + time.Unix((*b)[0].CreateTime, 0).UTC().Format(time.RFC3339)
+	}, func(data *hotspotVoucherResourceModel, b *[]client.Voucher) {
+		data.ID = types.StringValue((*b)[0].ID)
+		r.syncState(ctx, data, *b)
+	})
+}
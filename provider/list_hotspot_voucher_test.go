@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccHotspotVoucherListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Two batches created together, usually in the same second,
+				// must be listed separately.
+				Config: testAccHotspotVoucherListResourceConfig(),
+			},
+			{
+				Query:  true,
+				Config: testAccHotspotVoucherListConfig(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("unifi_hotspot_voucher.all", 2),
+					querycheck.ExpectResourceKnownValues("unifi_hotspot_voucher.all", queryfilter.ByDisplayName(knownvalue.StringExact("Query Vouchers 0")), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("quantity"), KnownValue: knownvalue.Int64Exact(2)},
+						{Path: tfjsonpath.New("voucher_ids"), KnownValue: knownvalue.ListSizeExact(2)},
+						{Path: tfjsonpath.New("duration"), KnownValue: knownvalue.Int64Exact(60)},
+					}),
+					querycheck.ExpectResourceKnownValues("unifi_hotspot_voucher.all", queryfilter.ByDisplayName(knownvalue.StringExact("Query Vouchers 1")), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("quantity"), KnownValue: knownvalue.Int64Exact(2)},
+						{Path: tfjsonpath.New("voucher_ids"), KnownValue: knownvalue.ListSizeExact(2)},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccHotspotVoucherListResourceConfig() string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_hotspot_voucher" "test" {
+  count    = 2
+  quantity = 2
+  duration = 60
+  note     = "Query Vouchers ${count.index}"
+}
+`, getProviderConfig())
+}
+
+func testAccHotspotVoucherListConfig() string {
+	return fmt.Sprintf(`
+%s
+
+list "unifi_hotspot_voucher" "all" {
+  provider         = unifi
+  include_resource = true
+}
+`, getProviderConfig())
+}
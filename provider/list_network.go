@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &networkListResource{}
+var _ list.ListResourceWithConfigure = &networkListResource{}
+
+func NewNetworkListResource() list.ListResource {
+	return &networkListResource{}
+}
+
+type networkListResource struct {
+	networkResource
+}
+
+func (r *networkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the networks of the site.",
+	}
+}
+
+func (r *networkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	networks, err := r.Client.ListNetworks(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing networks", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, networks, func(n *client.Network) (string, string) {
+		return n.ID, n.Name
+	}, r.syncState)
+}
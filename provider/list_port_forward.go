@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &portForwardListResource{}
+var _ list.ListResourceWithConfigure = &portForwardListResource{}
+
+func NewPortForwardListResource() list.ListResource {
+	return &portForwardListResource{}
+}
+
+type portForwardListResource struct {
+	portForwardResource
+}
+
+func (r *portForwardListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the port forwards of the site.",
+	}
+}
+
+func (r *portForwardListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	forwards, err := r.Client.ListPortForwards(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing port forwards", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, forwards, func(f *client.PortForward) (string, string) {
+		return f.ID, f.Name
+	}, r.syncState)
+}
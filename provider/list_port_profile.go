@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &portProfileListResource{}
+var _ list.ListResourceWithConfigure = &portProfileListResource{}
+
+func NewPortProfileListResource() list.ListResource {
+	return &portProfileListResource{}
+}
+
+type portProfileListResource struct {
+	portProfileResource
+}
+
+func (r *portProfileListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the port profiles of the site.",
+	}
+}
+
+func (r *portProfileListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	profiles, err := r.Client.ListPortProfiles(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing port profiles", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, profiles, func(p *client.PortConf) (string, string) {
+		return p.ID, p.Name
+	}, func(data *portProfileResourceModel, p *client.PortConf) {
+		r.syncState(ctx, data, p)
+	})
+}
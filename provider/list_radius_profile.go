@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &radiusProfileListResource{}
+var _ list.ListResourceWithConfigure = &radiusProfileListResource{}
+
+func NewRADIUSProfileListResource() list.ListResource {
+	return &radiusProfileListResource{}
+}
+
+type radiusProfileListResource struct {
+	radiusProfileResource
+}
+
+func (r *radiusProfileListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the RADIUS profiles of the site.",
+	}
+}
+
+func (r *radiusProfileListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	profiles, err := r.Client.ListRADIUSProfiles(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing RADIUS profiles", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, profiles, func(p *client.RADIUSProfile) (string, string) {
+		return p.ID, p.Name
+	}, func(data *radiusProfileResourceModel, p *client.RADIUSProfile) {
+		r.syncState(ctx, data, p)
+	})
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &settingIPSListResource{}
+var _ list.ListResourceWithConfigure = &settingIPSListResource{}
+
+func NewSettingIPSListResource() list.ListResource {
+	return &settingIPSListResource{}
+}
+
+type settingIPSListResource struct {
+	settingIPSResource
+}
+
+func (r *settingIPSListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the threat management settings of the site, which always exist.",
+	}
+}
+
+func (r *settingIPSListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ips, err := r.Client.GetSettingIPS(ctx)
+	if err != nil {
+		stream.Results = listError("Error reading IPS setting", err)
+		return
+	}
+	dpi, err := r.Client.GetSettingDPI(ctx)
+	if err != nil {
+		stream.Results = listError("Error reading DPI setting", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, []client.SettingIPS{*ips}, func(s *client.SettingIPS) (string, string) {
+		return s.ID, "Threat management"
+	}, func(data *settingIPSResourceModel, s *client.SettingIPS) {
+		r.syncState(ctx, data, s, dpi)
+	})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &staticDNSListResource{}
+var _ list.ListResourceWithConfigure = &staticDNSListResource{}
+
+func NewStaticDNSListResource() list.ListResource {
+	return &staticDNSListResource{}
+}
+
+type staticDNSListResource struct {
+	staticDNSResource
+}
+
+func (r *staticDNSListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the static DNS records of the site.",
+	}
+}
+
+func (r *staticDNSListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	records, err := r.Client.ListStaticDNS(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing static DNS records", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, records, func(record *client.StaticDNS) (string, string) {
+		return record.ID, record.Key
+	}, r.syncState)
+}
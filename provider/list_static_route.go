@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &staticRouteListResource{}
+var _ list.ListResourceWithConfigure = &staticRouteListResource{}
+
+func NewStaticRouteListResource() list.ListResource {
+	return &staticRouteListResource{}
+}
+
+type staticRouteListResource struct {
+	staticRouteResource
+}
+
+func (r *staticRouteListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the static routes of the site.",
+	}
+}
+
+func (r *staticRouteListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	routes, err := r.Client.ListStaticRoutes(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing static routes", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, routes, func(route *client.Routing) (string, string) {
+		return route.ID, route.Name
+	}, r.syncState)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &trafficRuleListResource{}
+var _ list.ListResourceWithConfigure = &trafficRuleListResource{}
+
+func NewTrafficRuleListResource() list.ListResource {
+	return &trafficRuleListResource{}
+}
+
+type trafficRuleListResource struct {
+	trafficRuleResource
+}
+
+func (r *trafficRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the traffic rules of the site.",
+	}
+}
+
+func (r *trafficRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	rules, err := r.Client.ListTrafficRules(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing traffic rules", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, rules, func(rule *client.TrafficRule) (string, string) {
+		if rule.Name != "" {
+			return rule.ID, rule.Name
+		}
+		return rule.ID, rule.Description
+	}, func(data *trafficRuleResourceModel, rule *client.TrafficRule) {
+		r.syncState(ctx, data, rule)
+	})
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &userListResource{}
+var _ list.ListResourceWithConfigure = &userListResource{}
+
+func NewUserListResource() list.ListResource {
+	return &userListResource{}
+}
+
+type userListResource struct {
+	userResource
+}
+
+func (r *userListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the clients of the site.",
+	}
+}
+
+func (r *userListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	users, err := r.Client.ListUsers(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing clients", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, users, func(u *client.User) (string, string) {
+		if u.Name != "" {
+			return u.ID, u.Name
+		}
+		return u.ID, u.MAC
+	}, r.syncState)
+}
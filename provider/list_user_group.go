@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+)
+
+var _ list.ListResource = &userGroupListResource{}
+var _ list.ListResourceWithConfigure = &userGroupListResource{}
+
+func NewUserGroupListResource() list.ListResource {
+	return &userGroupListResource{}
+}
+
+type userGroupListResource struct {
+	userGroupResource
+}
+
+func (r *userGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the user groups of the site.",
+	}
+}
+
+func (r *userGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	groups, err := r.Client.ListUserGroups(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing user groups", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, groups, func(g *client.UserGroup) (string, string) {
+		return g.ID, g.Name
+	}, r.syncState)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserGroupListResource(t *testing.T) {
+	byName := queryfilter.ByDisplayName(knownvalue.StringExact("Query Group"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupResourceConfig("Query Group", 2000, 1000),
+			},
+			{
+				Query:  true,
+				Config: testAccUserGroupListConfig(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("unifi_user_group.all", 2),
+					querycheck.ExpectResourceDisplayName("unifi_user_group.all", byName, knownvalue.StringExact("Query Group")),
+					querycheck.ExpectResourceKnownValues("unifi_user_group.all", byName, []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("download_limit"), KnownValue: knownvalue.Int64Exact(2000)},
+						{Path: tfjsonpath.New("upload_limit"), KnownValue: knownvalue.Int64Exact(1000)},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccUserGroupListConfig() string {
+	return fmt.Sprintf(`
+%s
+
+list "unifi_user_group" "all" {
+  provider         = unifi
+  include_resource = true
+}
+`, getProviderConfig())
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ list.ListResource = &wlanListResource{}
+var _ list.ListResourceWithConfigure = &wlanListResource{}
+
+func NewWLANListResource() list.ListResource {
+	return &wlanListResource{}
+}
+
+type wlanListResource struct {
+	wlanResource
+}
+
+func (r *wlanListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the WLANs of the site.",
+	}
+}
+
+func (r *wlanListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	wlans, err := r.Client.ListWLANs(ctx)
+	if err != nil {
+		stream.Results = listError("Error listing WLANs", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, wlans, func(w *client.WLANConf) (string, string) {
+		return w.ID, w.Name
+	}, func(data *wlanResourceModel, w *client.WLANConf) {
+		r.syncState(ctx, data, w)
+		// Read keeps the configured passphrase, but generated configuration
+		// needs the one the controller holds.
+		data.Passphrase = utils.StringToValue(w.XPassphrase)
+	})
+}
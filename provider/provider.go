@@ -3,16 +3,30 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 )
 
 var _ provider.Provider = &unifiProvider{}
+var _ provider.ProviderWithEphemeralResources = &unifiProvider{}
+var _ provider.ProviderWithFunctions = &unifiProvider{}
+var _ provider.ProviderWithActions = &unifiProvider{}
+var _ provider.ProviderWithListResources = &unifiProvider{}
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -34,6 +48,22 @@ type unifiProviderModel struct {
 	Site         types.String `tfsdk:"site"`
 	Insecure     types.Bool   `tfsdk:"allow_insecure"`
 	IsStandalone types.Bool   `tfsdk:"is_standalone"`
+
+	RequestTimeout         types.String `tfsdk:"request_timeout"`
+	MaxRetries             types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin           types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax           types.String `tfsdk:"retry_wait_max"`
+	HTTPSProxy             types.String `tfsdk:"https_proxy"`
+	CACertificate          types.String `tfsdk:"ca_certificate"`
+	CACertificateFile      types.String `tfsdk:"ca_certificate_file"`
+	CertificateFingerprint types.String `tfsdk:"certificate_fingerprint"`
+	ClientCertificate      types.String `tfsdk:"client_certificate"`
+	ClientCertificateFile  types.String `tfsdk:"client_certificate_file"`
+	ClientKey              types.String `tfsdk:"client_key"`
+	ClientKeyFile          types.String `tfsdk:"client_key_file"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
 }
 
 func (p *unifiProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -73,7 +103,83 @@ func (p *unifiProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			},
 			"is_standalone": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Set to true if using a standalone UniFi Network Application (no /proxy/network prefix). Defaults to false.",
+				Description: "Set to true if using a standalone UniFi Network Application (no /proxy/network prefix). Detected from the host when unset.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for a single HTTP request, as a duration (e.g. 30s). Defaults to 60s.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for failed requests. Defaults to 5.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum backoff between retries, as a duration. Defaults to 1s.",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum backoff between retries, as a duration, also capping waits requested through Retry-After. Defaults to 30s.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests in flight at once, regardless of Terraform's parallelism. Unlimited by default; small consoles may need a low value such as 4.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum rate of API requests per second, retries included. Unlimited by default.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"https_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy used to reach the controller. Defaults to the HTTPS_PROXY environment variable.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA certificate used to verify the controller, e.g. a console's self-signed certificate.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded CA certificate used to verify the controller.",
+			},
+			"certificate_fingerprint": schema.StringAttribute{
+				Optional: true,
+				Description: "SHA-256 fingerprint of the controller certificate to pin. When set, the certificate must match instead of chaining to a system CA. " +
+					"If ca_certificate or ca_certificate_file is also set, the certificate must both match the pin and chain to that CA.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mutual TLS.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_certificate_file")),
+				},
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded client certificate for mutual TLS.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key of the client certificate.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the PEM-encoded private key of the client certificate.",
 			},
 		},
 	}
@@ -122,12 +228,80 @@ func (p *unifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		insecure = data.Insecure.ValueBool()
 	}
 
-	isStandalone := false
+	var isStandalone *bool
 	if !data.IsStandalone.IsNull() {
-		isStandalone = data.IsStandalone.ValueBool()
+		v := data.IsStandalone.ValueBool()
+		isStandalone = &v
 	}
 
-	c, err := client.NewClient(host, username, password, apiKey, site, insecure, isStandalone)
+	transport := client.TransportConfig{
+		Insecure:        insecure,
+		Timeout:         60 * time.Second,
+		ProxyURL:        data.HTTPSProxy.ValueString(),
+		CertFingerprint: data.CertificateFingerprint.ValueString(),
+	}
+	if !data.MaxConcurrentRequests.IsNull() {
+		transport.MaxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+	}
+	if !data.RequestsPerSecond.IsNull() {
+		transport.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+	if !data.MaxRetries.IsNull() {
+		retries := int(data.MaxRetries.ValueInt64())
+		transport.RetryMax = &retries
+	}
+
+	for _, d := range []struct {
+		attr   string
+		value  types.String
+		target *time.Duration
+	}{
+		{"request_timeout", data.RequestTimeout, &transport.Timeout},
+		{"retry_wait_min", data.RetryWaitMin, &transport.RetryWaitMin},
+		{"retry_wait_max", data.RetryWaitMax, &transport.RetryWaitMax},
+	} {
+		if d.value.IsNull() {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(d.attr), "Invalid Duration", err.Error())
+			continue
+		}
+		*d.target = parsed
+	}
+
+	for _, p := range []struct {
+		attr     string
+		value    types.String
+		fileAttr string
+		file     types.String
+		target   *string
+	}{
+		{"ca_certificate", data.CACertificate, "ca_certificate_file", data.CACertificateFile, &transport.CACertPEM},
+		{"client_certificate", data.ClientCertificate, "client_certificate_file", data.ClientCertificateFile, &transport.ClientCertPEM},
+		{"client_key", data.ClientKey, "client_key_file", data.ClientKeyFile, &transport.ClientKeyPEM},
+	} {
+		if !p.value.IsNull() {
+			*p.target = p.value.ValueString()
+			continue
+		}
+		if p.file.IsNull() {
+			continue
+		}
+		content, err := os.ReadFile(p.file.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(p.fileAttr), "Unable to Read "+p.attr, err.Error())
+			continue
+		}
+		*p.target = string(content)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.NewClient(host, username, password, apiKey, site, isStandalone, transport)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", "Failed to create unifi client: "+err.Error())
 		return
@@ -135,6 +309,8 @@ func (p *unifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.ActionData = c
+	resp.ListResourceData = c
 }
 
 func (p *unifiProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -152,6 +328,64 @@ func (p *unifiProvider) Resources(_ context.Context) []func() resource.Resource
 		NewStaticRouteResource,
 		NewStaticDNSResource,
 		NewTrafficRuleResource,
+		NewSettingIPSResource,
+		NewContentFilterResource,
+		NewGuestPortalResource,
+		NewHotspotVoucherResource,
+		NewHotspotOperatorResource,
+		NewAdminResource,
+	}
+}
+
+func (p *unifiProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewNetworkListResource,
+		NewFirewallRuleListResource,
+		NewPortProfileListResource,
+		NewUserGroupListResource,
+		NewAPGroupListResource,
+		NewWLANListResource,
+		NewFirewallGroupListResource,
+		NewUserListResource,
+		NewRADIUSProfileListResource,
+		NewPortForwardListResource,
+		NewStaticRouteListResource,
+		NewStaticDNSListResource,
+		NewTrafficRuleListResource,
+		NewSettingIPSListResource,
+		NewContentFilterListResource,
+		NewGuestPortalListResource,
+		NewHotspotVoucherListResource,
+		NewHotspotOperatorListResource,
+		NewAdminListResource,
+	}
+}
+
+func (p *unifiProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRestartDeviceAction,
+		NewForceProvisionAction,
+		NewLocateDeviceAction,
+		NewPowerCyclePortAction,
+		NewUpgradeFirmwareAction,
+		NewKickClientAction,
+		NewBlockClientAction,
+	}
+}
+
+func (p *unifiProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewWLANPassphraseEphemeralResource,
+	}
+}
+
+func (p *unifiProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDHCPRangeFunction,
+		NewGatewayCIDRFunction,
+		NewNormalizeMACFunction,
+		NewVLANSubnetFunction,
+		NewWiFiQRFunction,
 	}
 }
 
@@ -164,5 +398,22 @@ func (p *unifiProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewFirewallGroupDataSource,
 		NewRADIUSProfileDataSource,
 		NewPortProfileDataSource,
+		NewAdminsDataSource,
+		NewDevicesDataSource,
+		NewDeviceDataSource,
+		NewClientsDataSource,
+		NewNetworksDataSource,
+		NewWLANsDataSource,
+		NewFirewallGroupsDataSource,
+		NewPortProfilesDataSource,
+		NewUserGroupsDataSource,
+		NewRADIUSProfilesDataSource,
+		NewAPGroupsDataSource,
+		NewStaticDNSRecordsDataSource,
+		NewTrafficRulesDataSource,
+		NewControllerDataSource,
+		NewDPIAppDataSource,
+		NewDPICategoryDataSource,
+		NewRegionsDataSource,
 	}
 }
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+// adminDeviceAdoptPermission is the site permission exposed as allow_device_adoption.
+const adminDeviceAdoptPermission = "API_DEVICE_ADOPT"
+
+var _ resource.Resource = &adminResource{}
+var _ resource.ResourceWithImportState = &adminResource{}
+var _ resource.ResourceWithIdentity = &adminResource{}
+
+func NewAdminResource() resource.Resource {
+	return &adminResource{}
+}
+
+type adminResource struct {
+	BaseResource
+}
+
+type adminResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Email               types.String `tfsdk:"email"`
+	Role                types.String `tfsdk:"role"`
+	Permissions         types.List   `tfsdk:"permissions"`
+	AllowDeviceAdoption types.Bool   `tfsdk:"allow_device_adoption"`
+	IsSuper             types.Bool   `tfsdk:"is_super"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *adminResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin"
+}
+
+func (r *adminResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a UniFi administrator's access to the current site. " +
+			"Administrators that already exist on the controller are granted access; otherwise an invitation is sent by email. " +
+			"Destroying the resource revokes the site access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the administrator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the administrator.",
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the administrator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The role of the administrator on the site (admin, readonly).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "readonly"),
+				},
+			},
+			"permissions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				MarkdownDescription: "Additional site permissions (e.g., API_DEVICE_RESTART, API_CLIENT_BLOCK, API_STAT_DEVICE_ACCESS_READONLY). " +
+					"Device adoption is granted with `allow_device_adoption` rather than API_DEVICE_ADOPT.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.ManagedBy(adminDeviceAdoptPermission, "allow_device_adoption")),
+				},
+			},
+			"allow_device_adoption": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the administrator may adopt devices to the site.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_super": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the administrator is a super administrator of the controller.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *adminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data adminResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	admin := r.expand(ctx, &data)
+
+	existing, err := r.Client.ListAllAdmins(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing admins", err.Error())
+		return
+	}
+
+	if found := findAdminByEmail(existing, admin.Email); found != nil {
+		admin.ID = found.ID
+		err = r.Client.GrantAdmin(ctx, admin)
+	} else {
+		err = r.Client.InviteAdmin(ctx, admin)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating admin", err.Error())
+		return
+	}
+
+	admins, err := r.Client.ListAdmins(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing admins", err.Error())
+		return
+	}
+
+	created := findAdminByEmail(admins, admin.Email)
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating admin", fmt.Sprintf("Admin %q was not found on the site after being added", admin.Email))
+		return
+	}
+
+	r.syncState(ctx, &data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *adminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data adminResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	admin, err := r.Client.GetAdmin(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading admin", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, admin)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *adminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data adminResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	admin := r.expand(ctx, &data)
+	admin.ID = data.ID.ValueString()
+
+	if err := r.Client.UpdateAdmin(ctx, admin); err != nil {
+		resp.Diagnostics.AddError("Error updating admin", err.Error())
+		return
+	}
+
+	updated, err := r.Client.GetAdmin(ctx, admin.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading admin", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *adminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data adminResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.Client.RevokeAdmin(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error revoking admin", err.Error())
+		return
+	}
+}
+
+func (r *adminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *adminResource) expand(ctx context.Context, data *adminResourceModel) *client.Admin {
+	permissions := []string{}
+	if !data.Permissions.IsNull() && !data.Permissions.IsUnknown() {
+		data.Permissions.ElementsAs(ctx, &permissions, false)
+	}
+	if data.AllowDeviceAdoption.ValueBool() {
+		permissions = append(permissions, adminDeviceAdoptPermission)
+	}
+
+	return &client.Admin{
+		Name:        data.Name.ValueString(),
+		Email:       data.Email.ValueString(),
+		Role:        data.Role.ValueString(),
+		Permissions: permissions,
+	}
+}
+
+func (r *adminResource) syncState(ctx context.Context, data *adminResourceModel, admin *client.Admin) {
+	data.ID = types.StringValue(admin.ID)
+	data.Name = types.StringValue(admin.Name)
+	data.Email = types.StringValue(admin.Email)
+	data.Role = types.StringValue(admin.Role)
+	data.IsSuper = utils.BoolValue(admin.IsSuper)
+	if data.IsSuper.IsNull() {
+		data.IsSuper = types.BoolValue(false)
+	}
+
+	adopt := false
+	permissions := []string{}
+	for _, p := range admin.Permissions {
+		if p == adminDeviceAdoptPermission {
+			adopt = true
+			continue
+		}
+		permissions = append(permissions, p)
+	}
+	data.AllowDeviceAdoption = types.BoolValue(adopt)
+	data.Permissions, _ = types.ListValueFrom(ctx, types.StringType, permissions)
+}
+
+func findAdminByEmail(admins []client.Admin, email string) *client.Admin {
+	for _, a := range admins {
+		if strings.EqualFold(a.Email, email) {
+			return &a
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminResourceConfig("readonly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_admin.test", "id"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "email", "tf-acc-admin@example.com"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "role", "readonly"),
+				),
+			},
+			{
+				Config: testAccAdminResourceConfig("admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_admin.test", "role", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdminResource_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getProviderConfig() + `
+resource "unifi_admin" "test" {
+  name        = "tf-acc-admin"
+  email       = "tf-acc-admin@example.com"
+  role        = "admin"
+  permissions = ["API_DEVICE_ADOPT"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`allow_device_adoption`),
+			},
+		},
+	})
+}
+
+func testAccAdminResourceConfig(role string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_admin" "test" {
+  name  = "tf-acc-admin"
+  email = "tf-acc-admin@example.com"
+  role  = %[2]q
+}
+`, getProviderConfig(), role)
+}
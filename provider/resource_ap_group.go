@@ -2,19 +2,24 @@ package provider
 
 import (
 	"context"
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &apGroupResource{}
 var _ resource.ResourceWithImportState = &apGroupResource{}
+var _ resource.ResourceWithIdentity = &apGroupResource{}
 
 func NewAPGroupResource() resource.Resource {
 	return &apGroupResource{}
@@ -29,13 +34,17 @@ type apGroupResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	DeviceMACs  types.List   `tfsdk:"device_macs"`
 	ForWLANConf types.Bool   `tfsdk:"for_wlanconf"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *apGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ap_group"
 }
 
-func (r *apGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *apGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi access point group.",
 		Attributes: map[string]schema.Attribute{
@@ -51,16 +60,28 @@ func (r *apGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "The name of the AP group.",
 			},
 			"device_macs": schema.ListAttribute{
-				ElementType:         types.StringType,
+				ElementType:         utils.MACAddressType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The MAC addresses of the devices in the AP group.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.MACAddress()),
+				},
 			},
 			"for_wlanconf": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Whether the AP group is used for WLAN configuration.",
 			},
+			"adopt_existing": adoptExistingAttribute("AP group"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -72,6 +93,9 @@ func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	deviceMACs := []string{}
 	if !data.DeviceMACs.IsNull() && !data.DeviceMACs.IsUnknown() {
 		resp.Diagnostics.Append(data.DeviceMACs.ElementsAs(ctx, &deviceMACs, false)...)
@@ -80,13 +104,25 @@ func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	group := &client.APGroup{
-		Name:        data.Name.ValueString(),
-		DeviceMACs:  deviceMACs,
-		ForWLANConf: utils.BoolPtr(data.ForWLANConf),
+	existing, err := findAdoptable(ctx, data.AdoptExisting, data.Name.ValueString(), r.Client.ListAPGroups, func(g *client.APGroup) string { return g.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing AP groups", err.Error())
+		return
 	}
 
-	created, err := r.Client.CreateAPGroup(ctx, group)
+	group := existing
+	if group == nil {
+		group = &client.APGroup{Name: data.Name.ValueString()}
+	}
+	group.DeviceMACs = deviceMACs
+	group.ForWLANConf = utils.BoolPtr(data.ForWLANConf)
+
+	var created *client.APGroup
+	if existing != nil {
+		created, err = r.Client.UpdateAPGroup(ctx, existing.ID, group)
+	} else {
+		created, err = r.Client.CreateAPGroup(ctx, group)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating AP group", err.Error())
 		return
@@ -94,6 +130,7 @@ func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest
 
 	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *apGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,6 +140,9 @@ func (r *apGroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	group, err := r.Client.GetAPGroup(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading AP group", err.Error())
@@ -111,6 +151,7 @@ func (r *apGroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	r.syncState(ctx, &data, group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -120,6 +161,9 @@ func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	deviceMACs := []string{}
 	if !data.DeviceMACs.IsNull() && !data.DeviceMACs.IsUnknown() {
 		resp.Diagnostics.Append(data.DeviceMACs.ElementsAs(ctx, &deviceMACs, false)...)
@@ -143,6 +187,7 @@ func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *apGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -152,6 +197,14 @@ func (r *apGroupResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if group, err := r.Client.GetAPGroup(ctx, data.ID.ValueString()); err == nil && isBuiltIn(group.AttrNoDelete, group.AttrHiddenID) {
+		warnBuiltInNotDeleted(&resp.Diagnostics, "AP group", group.Name)
+		return
+	}
+
 	if err := r.Client.DeleteAPGroup(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting AP group", err.Error())
 		return
@@ -159,7 +212,7 @@ func (r *apGroupResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *apGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *apGroupResource) syncState(ctx context.Context, data *apGroupResourceModel, group *client.APGroup) {
@@ -167,6 +220,6 @@ func (r *apGroupResource) syncState(ctx context.Context, data *apGroupResourceMo
 	data.Name = types.StringValue(group.Name)
 	data.ForWLANConf = utils.BoolValue(group.ForWLANConf)
 
-	macs, _ := types.ListValueFrom(ctx, types.StringType, group.DeviceMACs)
+	macs, _ := types.ListValueFrom(ctx, utils.MACAddressType{}, group.DeviceMACs)
 	data.DeviceMACs = macs
 }
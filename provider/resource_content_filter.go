@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ resource.Resource = &contentFilterResource{}
+var _ resource.ResourceWithImportState = &contentFilterResource{}
+var _ resource.ResourceWithModifyPlan = &contentFilterResource{}
+var _ resource.ResourceWithIdentity = &contentFilterResource{}
+
+func NewContentFilterResource() resource.Resource {
+	return &contentFilterResource{}
+}
+
+type contentFilterResource struct {
+	BaseResource
+}
+
+type contentFilterResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	NetworkIDs types.List   `tfsdk:"network_ids"`
+	ClientMACs types.List   `tfsdk:"client_macs"`
+	Categories types.List   `tfsdk:"categories"`
+	SafeSearch types.List   `tfsdk:"safe_search"`
+	AllowList  types.List   `tfsdk:"allow_list"`
+	BlockList  types.List   `tfsdk:"block_list"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *contentFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_filter"
+}
+
+func (r *contentFilterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a UniFi DNS content filtering profile (v2 API).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the content filter.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the content filter.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the content filter is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the networks the filter applies to.",
+			},
+			"client_macs": schema.ListAttribute{
+				ElementType:         utils.MACAddressType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The MAC addresses of individual clients the filter applies to.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.MACAddress()),
+				},
+			},
+			"categories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The content categories to block (e.g., ADVERTISEMENT, GAMBLING, ADULT, SOCIAL_NETWORKS).",
+			},
+			"safe_search": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The search engines to enforce safe search on (e.g., GOOGLE, YOUTUBE, BING).",
+			},
+			"allow_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Domains that are always allowed.",
+			},
+			"block_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Domains that are always blocked.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *contentFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data contentFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	filter := r.expand(ctx, &data)
+	if filter.Enabled == nil {
+		enabled := true
+		filter.Enabled = &enabled
+	}
+
+	created, err := r.Client.CreateContentFilter(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating content filter", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *contentFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data contentFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	filter, err := r.Client.GetContentFilter(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading content filter", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, filter)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *contentFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data contentFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	filter := r.expand(ctx, &data)
+	filter.ID = data.ID.ValueString()
+
+	updated, err := r.Client.UpdateContentFilter(ctx, data.ID.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating content filter", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *contentFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data contentFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.Client.DeleteContentFilter(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting content filter", err.Error())
+		return
+	}
+}
+
+func (r *contentFilterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.requireVersion(ctx, "unifi_content_filter", "8.0", &resp.Diagnostics)
+}
+
+func (r *contentFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *contentFilterResource) expand(ctx context.Context, data *contentFilterResourceModel) *client.ContentFilter {
+	stringList := func(l types.List) []string {
+		values := []string{}
+		if !l.IsNull() && !l.IsUnknown() {
+			l.ElementsAs(ctx, &values, false)
+		}
+		return values
+	}
+
+	return &client.ContentFilter{
+		Name:       data.Name.ValueString(),
+		Enabled:    utils.BoolPtr(data.Enabled),
+		NetworkIDs: stringList(data.NetworkIDs),
+		ClientMACs: stringList(data.ClientMACs),
+		Categories: stringList(data.Categories),
+		SafeSearch: stringList(data.SafeSearch),
+		AllowList:  stringList(data.AllowList),
+		BlockList:  stringList(data.BlockList),
+	}
+}
+
+func (r *contentFilterResource) syncState(ctx context.Context, data *contentFilterResourceModel, filter *client.ContentFilter) {
+	data.ID = types.StringValue(filter.ID)
+	data.Name = types.StringValue(filter.Name)
+	data.Enabled = utils.BoolValue(filter.Enabled)
+
+	data.NetworkIDs, _ = types.ListValueFrom(ctx, types.StringType, filter.NetworkIDs)
+	data.ClientMACs, _ = types.ListValueFrom(ctx, utils.MACAddressType{}, filter.ClientMACs)
+	data.Categories, _ = types.ListValueFrom(ctx, types.StringType, filter.Categories)
+	data.SafeSearch, _ = types.ListValueFrom(ctx, types.StringType, filter.SafeSearch)
+	data.AllowList, _ = types.ListValueFrom(ctx, types.StringType, filter.AllowList)
+	data.BlockList, _ = types.ListValueFrom(ctx, types.StringType, filter.BlockList)
+}
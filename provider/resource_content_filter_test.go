@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContentFilterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentFilterResourceConfig("Kids Filter"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_content_filter.test", "name", "Kids Filter"),
+					resource.TestCheckResourceAttr("unifi_content_filter.test", "network_ids.#", "1"),
+					resource.TestCheckResourceAttr("unifi_content_filter.test", "block_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContentFilterResourceConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name    = "Content Filter Network"
+  vlan_id = 210
+  subnet  = "192.168.210.1/24"
+  purpose = "corporate"
+}
+
+resource "unifi_content_filter" "test" {
+  name        = %[2]q
+  network_ids = [unifi_network.test.id]
+  categories  = ["ADULT", "GAMBLING"]
+  safe_search = ["GOOGLE", "YOUTUBE"]
+  block_list  = ["example.com"]
+}
+`, getProviderConfig(), name)
+}
@@ -1,19 +1,24 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &firewallGroupResource{}
 var _ resource.ResourceWithImportState = &firewallGroupResource{}
+var _ resource.ResourceWithValidateConfig = &firewallGroupResource{}
+var _ resource.ResourceWithIdentity = &firewallGroupResource{}
 
 func NewFirewallGroupResource() resource.Resource {
 	return &firewallGroupResource{}
@@ -28,13 +33,15 @@ type firewallGroupResourceModel struct {
 	Name         types.String `tfsdk:"name"`
 	GroupType    types.String `tfsdk:"group_type"`
 	GroupMembers types.List   `tfsdk:"group_members"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *firewallGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_firewall_group"
 }
 
-func (r *firewallGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi firewall group.",
 		Attributes: map[string]schema.Attribute{
@@ -54,11 +61,19 @@ func (r *firewallGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "The type of the firewall group (e.g., address-group, port-group, ipv6-address-group).",
 			},
 			"group_members": schema.ListAttribute{
-				ElementType:         types.StringType,
+				ElementType:         utils.CIDRType{},
 				Required:            true,
 				MarkdownDescription: "The members of the firewall group.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -69,6 +84,9 @@ func (r *firewallGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var members []string
 	resp.Diagnostics.Append(data.GroupMembers.ElementsAs(ctx, &members, false)...)
 	if resp.Diagnostics.HasError() {
@@ -89,6 +107,7 @@ func (r *firewallGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -98,6 +117,9 @@ func (r *firewallGroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	group, err := r.Client.GetFirewallGroup(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading firewall group", err.Error())
@@ -106,6 +128,7 @@ func (r *firewallGroupResource) Read(ctx context.Context, req resource.ReadReque
 
 	r.syncState(ctx, &data, group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -115,6 +138,9 @@ func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var members []string
 	resp.Diagnostics.Append(data.GroupMembers.ElementsAs(ctx, &members, false)...)
 	if resp.Diagnostics.HasError() {
@@ -136,6 +162,7 @@ func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateR
 
 	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -145,14 +172,50 @@ func (r *firewallGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteFirewallGroup(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting firewall group", err.Error())
 		return
 	}
 }
 
+// ValidateConfig checks the members against the group type, which decides
+// whether they are addresses or ports.
+func (r *firewallGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data firewallGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.GroupType.IsUnknown() || data.GroupMembers.IsNull() || data.GroupMembers.IsUnknown() {
+		return
+	}
+
+	var check func(string) error
+	switch data.GroupType.ValueString() {
+	case "address-group":
+		check = func(s string) error { return validators.CheckAddressMember(s, false) }
+	case "ipv6-address-group":
+		check = func(s string) error { return validators.CheckAddressMember(s, true) }
+	case "port-group":
+		check = validators.CheckPortRange
+	default:
+		return
+	}
+
+	var members []utils.CIDRValue
+	resp.Diagnostics.Append(data.GroupMembers.ElementsAs(ctx, &members, false)...)
+	for i, m := range members {
+		if m.IsNull() || m.IsUnknown() {
+			continue
+		}
+		if err := check(m.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("group_members").AtListIndex(i), "Invalid Group Member", err.Error()+".")
+		}
+	}
+}
+
 func (r *firewallGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *firewallGroupResource) syncState(ctx context.Context, data *firewallGroupResourceModel, group *client.FirewallGroup) {
@@ -160,6 +223,6 @@ func (r *firewallGroupResource) syncState(ctx context.Context, data *firewallGro
 	data.Name = types.StringValue(group.Name)
 	data.GroupType = types.StringValue(group.GroupType)
 
-	members, _ := types.ListValueFrom(ctx, types.StringType, group.GroupMembers)
+	members, _ := types.ListValueFrom(ctx, utils.CIDRType{}, group.GroupMembers)
 	data.GroupMembers = members
 }
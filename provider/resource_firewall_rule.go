@@ -1,9 +1,9 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,12 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &firewallRuleResource{}
 var _ resource.ResourceWithImportState = &firewallRuleResource{}
+var _ resource.ResourceWithIdentity = &firewallRuleResource{}
 
 func NewFirewallRuleResource() resource.Resource {
 	return &firewallRuleResource{}
@@ -28,32 +32,34 @@ type firewallRuleResource struct {
 }
 
 type firewallRuleResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
-	Ruleset          types.String `tfsdk:"ruleset"`
-	Action           types.String `tfsdk:"action"`
-	Protocol         types.String `tfsdk:"protocol"`
-	SrcNetworkID     types.String `tfsdk:"src_network_id"`
-	SrcNetworkType   types.String `tfsdk:"src_network_type"`
-	SrcAddress       types.String `tfsdk:"src_address"`
-	DstNetworkID     types.String `tfsdk:"dst_network_id"`
-	DstNetworkType   types.String `tfsdk:"dst_network_type"`
-	DstAddress       types.String `tfsdk:"dst_address"`
-	StateEstablished types.Bool   `tfsdk:"state_established"`
-	StateInvalid     types.Bool   `tfsdk:"state_invalid"`
-	StateNew         types.Bool   `tfsdk:"state_new"`
-	StateRelated     types.Bool   `tfsdk:"state_related"`
-	IPSec            types.String `tfsdk:"ipsec"`
-	RuleIndex        types.Int64  `tfsdk:"rule_index"`
-	Logging          types.Bool   `tfsdk:"logging"`
+	ID               types.String    `tfsdk:"id"`
+	Name             types.String    `tfsdk:"name"`
+	Enabled          types.Bool      `tfsdk:"enabled"`
+	Ruleset          types.String    `tfsdk:"ruleset"`
+	Action           types.String    `tfsdk:"action"`
+	Protocol         types.String    `tfsdk:"protocol"`
+	SrcNetworkID     types.String    `tfsdk:"src_network_id"`
+	SrcNetworkType   types.String    `tfsdk:"src_network_type"`
+	SrcAddress       utils.CIDRValue `tfsdk:"src_address"`
+	DstNetworkID     types.String    `tfsdk:"dst_network_id"`
+	DstNetworkType   types.String    `tfsdk:"dst_network_type"`
+	DstAddress       utils.CIDRValue `tfsdk:"dst_address"`
+	StateEstablished types.Bool      `tfsdk:"state_established"`
+	StateInvalid     types.Bool      `tfsdk:"state_invalid"`
+	StateNew         types.Bool      `tfsdk:"state_new"`
+	StateRelated     types.Bool      `tfsdk:"state_related"`
+	IPSec            types.String    `tfsdk:"ipsec"`
+	RuleIndex        types.Int64     `tfsdk:"rule_index"`
+	Logging          types.Bool      `tfsdk:"logging"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *firewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
 }
 
-func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi firewall rule.",
 		Attributes: map[string]schema.Attribute{
@@ -107,12 +113,16 @@ func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"src_address": schema.StringAttribute{
+				CustomType:          utils.CIDRType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Source IP address or CIDR.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPOrCIDR(),
+				},
 			},
 			"dst_network_id": schema.StringAttribute{
 				Optional:            true,
@@ -131,12 +141,16 @@ func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"dst_address": schema.StringAttribute{
+				CustomType:          utils.CIDRType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Destination IP address or CIDR.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPOrCIDR(),
+				},
 			},
 			"state_established": schema.BoolAttribute{
 				Optional:            true,
@@ -195,6 +209,14 @@ func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -205,6 +227,9 @@ func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	rule := &client.FirewallRule{
 		Name:               data.Name.ValueString(),
 		Enabled:            utils.BoolPtr(data.Enabled),
@@ -234,6 +259,7 @@ func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRe
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -243,6 +269,9 @@ func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	rule, err := r.Client.GetFirewallRule(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading firewall rule", err.Error())
@@ -251,6 +280,7 @@ func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadReques
 
 	r.syncState(&data, rule)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -260,6 +290,9 @@ func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	rule := &client.FirewallRule{
 		ID:                 data.ID.ValueString(),
 		Name:               data.Name.ValueString(),
@@ -290,6 +323,7 @@ func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRe
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -299,6 +333,9 @@ func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteFirewallRule(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting firewall rule", err.Error())
 		return
@@ -306,7 +343,7 @@ func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *firewallRuleResource) syncState(data *firewallRuleResourceModel, rule *client.FirewallRule) {
@@ -318,10 +355,10 @@ func (r *firewallRuleResource) syncState(data *firewallRuleResourceModel, rule *
 	data.Protocol = types.StringValue(rule.Protocol)
 	data.SrcNetworkID = types.StringValue(rule.SrcNetworkConfID)
 	data.SrcNetworkType = types.StringValue(rule.SrcNetworkConfType)
-	data.SrcAddress = types.StringValue(rule.SrcAddress)
+	data.SrcAddress = utils.NewCIDRValue(rule.SrcAddress)
 	data.DstNetworkID = types.StringValue(rule.DstNetworkConfID)
 	data.DstNetworkType = types.StringValue(rule.DstNetworkConfType)
-	data.DstAddress = types.StringValue(rule.DstAddress)
+	data.DstAddress = utils.NewCIDRValue(rule.DstAddress)
 	data.StateEstablished = utils.BoolValue(rule.StateEstablished)
 	data.StateInvalid = utils.BoolValue(rule.StateInvalid)
 	data.StateNew = utils.BoolValue(rule.StateNew)
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
+)
+
+var _ resource.Resource = &guestPortalResource{}
+var _ resource.ResourceWithImportState = &guestPortalResource{}
+var _ resource.ResourceWithIdentity = &guestPortalResource{}
+
+func NewGuestPortalResource() resource.Resource {
+	return &guestPortalResource{}
+}
+
+type guestPortalResource struct {
+	BaseResource
+}
+
+type guestPortalResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Auth            types.String `tfsdk:"auth"`
+	PortalEnabled   types.Bool   `tfsdk:"portal_enabled"`
+	Expire          types.Int64  `tfsdk:"expire"`
+	RedirectURL     types.String `tfsdk:"redirect_url"`
+	Title           types.String `tfsdk:"title"`
+	TermsOfService  types.String `tfsdk:"terms_of_service"`
+	PasswordEnabled types.Bool   `tfsdk:"password_enabled"`
+	Password        types.String `tfsdk:"password"`
+	VoucherEnabled  types.Bool   `tfsdk:"voucher_enabled"`
+	PaymentEnabled  types.Bool   `tfsdk:"payment_enabled"`
+	PaymentGateway  types.String `tfsdk:"payment_gateway"`
+	AllowedSubnets  types.List   `tfsdk:"allowed_subnets"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *guestPortalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guest_portal"
+}
+
+func (r *guestPortalResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the UniFi guest portal (hotspot) settings of a site. " +
+			"The setting is a singleton: destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the guest access setting.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The guest authentication method (none, hotspot, facebook_wifi, custom).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "hotspot", "facebook_wifi", "custom"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the guest portal is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How long guests stay authorized, in minutes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL guests are redirected to after authorization.",
+			},
+			"title": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The title shown on the portal page.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"terms_of_service": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Terms of service guests must accept. Setting this enables the terms of service checkbox.",
+			},
+			"password_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether guests can authenticate with a shared password.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The shared hotspot password. When it has never been set, the current password is kept.",
+			},
+			"voucher_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether guests can authenticate with hotspot vouchers.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payment_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether guests can pay for access.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payment_gateway": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The payment gateway (e.g., paypal, stripe, authorize, quickpay, merchantwarrior, ippay).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_subnets": schema.ListAttribute{
+				ElementType:         utils.CIDRType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Subnets guests may reach before authorization (at most 3). Set it to an empty list to remove them; when omitted, the current subnets are kept.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+					listvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *guestPortalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data guestPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	updated, err := r.apply(ctx, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating guest portal", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *guestPortalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data guestPortalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	setting, err := r.Client.GetSettingGuestAccess(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading guest portal", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, setting)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *guestPortalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state guestPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	// A password removed from the configuration is cleared; one that was
+	// never managed is left alone.
+	updated, err := r.apply(ctx, &data, !state.Password.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating guest portal", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *guestPortalResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Site settings cannot be deleted; removing the resource only drops it from state.
+}
+
+func (r *guestPortalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// apply overlays the configured attributes on the current setting so that
+// attributes left out of the configuration keep their current values. The
+// redirect URL and terms of service are always managed: leaving them out
+// turns them off. The password is cleared when clearPassword is set.
+func (r *guestPortalResource) apply(ctx context.Context, data *guestPortalResourceModel, clearPassword bool) (*client.SettingGuestAccess, error) {
+	setting, err := r.Client.GetSettingGuestAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if auth := utils.StringOrEmpty(data.Auth); auth != "" {
+		setting.Auth = auth
+	}
+	if enabled := utils.BoolPtr(data.PortalEnabled); enabled != nil {
+		setting.PortalEnabled = enabled
+	}
+	if expire := utils.Int64Ptr(data.Expire); expire != nil {
+		setting.Expire = expire
+	}
+	if title := utils.StringOrEmpty(data.Title); title != "" {
+		setting.PortalCustomizedTitle = title
+	}
+	if enabled := utils.BoolPtr(data.PasswordEnabled); enabled != nil {
+		setting.PasswordEnabled = enabled
+	}
+	if enabled := utils.BoolPtr(data.VoucherEnabled); enabled != nil {
+		setting.VoucherEnabled = enabled
+	}
+	if enabled := utils.BoolPtr(data.PaymentEnabled); enabled != nil {
+		setting.PaymentEnabled = enabled
+	}
+	if gateway := utils.StringOrEmpty(data.PaymentGateway); gateway != "" {
+		setting.Gateway = gateway
+	}
+
+	redirectEnabled := data.RedirectURL.ValueString() != ""
+	setting.RedirectEnabled = &redirectEnabled
+	setting.RedirectURL = data.RedirectURL.ValueString()
+	tosEnabled := data.TermsOfService.ValueString() != ""
+	setting.PortalCustomizedTOSEnabled = &tosEnabled
+	setting.PortalCustomizedTOS = data.TermsOfService.ValueString()
+
+	if !data.Password.IsNull() || clearPassword {
+		setting.XPassword = data.Password.ValueString()
+	}
+
+	if !data.AllowedSubnets.IsNull() && !data.AllowedSubnets.IsUnknown() {
+		var subnets []string
+		data.AllowedSubnets.ElementsAs(ctx, &subnets, false)
+		subnets = append(subnets, "", "", "")
+		setting.AllowedSubnet1 = subnets[0]
+		setting.AllowedSubnet2 = subnets[1]
+		setting.AllowedSubnet3 = subnets[2]
+	}
+
+	return r.Client.UpdateSettingGuestAccess(ctx, setting)
+}
+
+func (r *guestPortalResource) syncState(ctx context.Context, data *guestPortalResourceModel, setting *client.SettingGuestAccess) {
+	data.ID = types.StringValue(setting.ID)
+	data.Auth = types.StringValue(setting.Auth)
+	data.PortalEnabled = utils.BoolValue(setting.PortalEnabled)
+	data.Expire = utils.Int64Value(setting.Expire)
+	data.Title = types.StringValue(setting.PortalCustomizedTitle)
+	data.PasswordEnabled = utils.BoolValue(setting.PasswordEnabled)
+	data.VoucherEnabled = utils.BoolValue(setting.VoucherEnabled)
+	data.PaymentEnabled = utils.BoolValue(setting.PaymentEnabled)
+	data.PaymentGateway = types.StringValue(setting.Gateway)
+
+	if setting.RedirectEnabled != nil && *setting.RedirectEnabled {
+		data.RedirectURL = utils.StringToValue(setting.RedirectURL)
+	} else {
+		data.RedirectURL = types.StringNull()
+	}
+
+	if setting.PortalCustomizedTOSEnabled != nil && *setting.PortalCustomizedTOSEnabled {
+		data.TermsOfService = utils.StringToValue(setting.PortalCustomizedTOS)
+	} else {
+		data.TermsOfService = types.StringNull()
+	}
+
+	// The controller may not echo the hotspot password; keep the configured
+	// value, and leave an unmanaged password out of state.
+	if !data.Password.IsNull() && setting.XPassword != "" {
+		data.Password = types.StringValue(setting.XPassword)
+	}
+
+	subnets := []string{}
+	for _, s := range []string{setting.AllowedSubnet1, setting.AllowedSubnet2, setting.AllowedSubnet3} {
+		if s != "" {
+			subnets = append(subnets, s)
+		}
+	}
+	data.AllowedSubnets, _ = types.ListValueFrom(ctx, utils.CIDRType{}, subnets)
+}
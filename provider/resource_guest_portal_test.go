@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+func TestAccGuestPortalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuestPortalResourceConfig("Welcome", 480),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "auth", "hotspot"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "title", "Welcome"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "expire", "480"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "voucher_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccGuestPortalResourceConfig("Welcome Guests", 1440),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "title", "Welcome Guests"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "expire", "1440"),
+				),
+			},
+			{
+				Config: testAccGuestPortalResourceOptionalConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "redirect_url", "https://example.com/"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "terms_of_service", "Be nice."),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "password", "guestpass"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "allowed_subnets.#", "2"),
+				),
+			},
+			{
+				// Removing the optional attributes must clear them on the controller;
+				// the subnets are computed, so they are cleared with an empty list.
+				Config: testAccGuestPortalResourceClearedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unifi_guest_portal.test", "redirect_url"),
+					resource.TestCheckNoResourceAttr("unifi_guest_portal.test", "terms_of_service"),
+					resource.TestCheckNoResourceAttr("unifi_guest_portal.test", "password"),
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "allowed_subnets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGuestPortalResource_unconfigured(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuestPortalResourceOptionalConfig(),
+			},
+			{
+				// Destroying the singleton only removes it from state.
+				Config: getProviderConfig(),
+			},
+			{
+				// A portal managed without subnets keeps the ones already set.
+				Config: testAccGuestPortalResourceConfig("Welcome Guests", 1440),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_guest_portal.test", "allowed_subnets.#", "2"),
+					resource.TestCheckNoResourceAttr("unifi_guest_portal.test", "password"),
+				),
+			},
+			{
+				Config:   testAccGuestPortalResourceConfig("Welcome Guests", 1440),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestGuestPortalApplyKeepsUnconfigured checks against a fake controller that
+// subnets and a password left out of the configuration are sent unchanged.
+func TestGuestPortalApplyKeepsUnconfigured(t *testing.T) {
+	current := client.SettingGuestAccess{
+		ID:             "setting",
+		Key:            "guest_access",
+		Auth:           "hotspot",
+		XPassword:      "existing",
+		AllowedSubnet1: "10.0.0.0/24",
+		AllowedSubnet2: "10.0.1.0/24",
+	}
+	var sent client.SettingGuestAccess
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("decoding request: %s", err)
+			}
+			current = sent
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"meta": map[string]string{"rc": "ok"},
+			"data": []client.SettingGuestAccess{current},
+		})
+	}))
+	defer srv.Close()
+
+	standalone := true
+	c, err := client.NewClient(srv.URL, "", "", "key", "default", &standalone, client.TransportConfig{})
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	r := &guestPortalResource{BaseResource{Client: c}}
+
+	tests := map[string]struct {
+		data          guestPortalResourceModel
+		clearPassword bool
+		wantPassword  string
+		wantSubnet1   string
+	}{
+		"unconfigured": {
+			data:         guestPortalResourceModel{Password: types.StringNull(), AllowedSubnets: types.ListUnknown(utils.CIDRType{})},
+			wantPassword: "existing",
+			wantSubnet1:  "10.0.0.0/24",
+		},
+		"password removed": {
+			data:          guestPortalResourceModel{Password: types.StringNull(), AllowedSubnets: types.ListUnknown(utils.CIDRType{})},
+			clearPassword: true,
+			wantPassword:  "",
+			wantSubnet1:   "10.0.0.0/24",
+		},
+		"subnets emptied": {
+			data:         guestPortalResourceModel{Password: types.StringValue("new"), AllowedSubnets: types.ListValueMust(utils.CIDRType{}, nil)},
+			wantPassword: "new",
+			wantSubnet1:  "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			current.XPassword = "existing"
+			current.AllowedSubnet1, current.AllowedSubnet2 = "10.0.0.0/24", "10.0.1.0/24"
+
+			if _, err := r.apply(context.Background(), &tc.data, tc.clearPassword); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if sent.XPassword != tc.wantPassword {
+				t.Errorf("got password %q, want %q", sent.XPassword, tc.wantPassword)
+			}
+			if sent.AllowedSubnet1 != tc.wantSubnet1 {
+				t.Errorf("got allowed_subnet_1 %q, want %q", sent.AllowedSubnet1, tc.wantSubnet1)
+			}
+			if sent.Auth != "hotspot" {
+				t.Errorf("got auth %q, want the current value", sent.Auth)
+			}
+		})
+	}
+}
+
+func testAccGuestPortalResourceConfig(title string, expire int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_guest_portal" "test" {
+  auth            = "hotspot"
+  portal_enabled  = true
+  title           = %[2]q
+  expire          = %[3]d
+  voucher_enabled = true
+}
+`, getProviderConfig(), title, expire)
+}
+
+func testAccGuestPortalResourceOptionalConfig() string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_guest_portal" "test" {
+  auth             = "hotspot"
+  portal_enabled   = true
+  title            = "Welcome Guests"
+  expire           = 1440
+  voucher_enabled  = true
+  password         = "guestpass"
+  redirect_url     = "https://example.com/"
+  terms_of_service = "Be nice."
+  allowed_subnets  = ["10.0.0.0/24", "10.0.1.0/24"]
+}
+`, getProviderConfig())
+}
+
+func testAccGuestPortalResourceClearedConfig() string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_guest_portal" "test" {
+  auth            = "hotspot"
+  portal_enabled  = true
+  title           = "Welcome Guests"
+  expire          = 1440
+  voucher_enabled = true
+  allowed_subnets = []
+}
+`, getProviderConfig())
+}
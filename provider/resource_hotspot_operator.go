@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ resource.Resource = &hotspotOperatorResource{}
+var _ resource.ResourceWithImportState = &hotspotOperatorResource{}
+var _ resource.ResourceWithIdentity = &hotspotOperatorResource{}
+
+func NewHotspotOperatorResource() resource.Resource {
+	return &hotspotOperatorResource{}
+}
+
+type hotspotOperatorResource struct {
+	BaseResource
+}
+
+type hotspotOperatorResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Password types.String `tfsdk:"password"`
+	Note     types.String `tfsdk:"note"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *hotspotOperatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hotspot_operator"
+}
+
+func (r *hotspotOperatorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a UniFi hotspot operator account, used by staff to create and print vouchers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the hotspot operator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The login name of the hotspot operator.",
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the hotspot operator.",
+			},
+			"note": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A note for the hotspot operator.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *hotspotOperatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data hotspotOperatorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	operator := &client.HotspotOperator{
+		Name:      data.Name.ValueString(),
+		XPassword: data.Password.ValueString(),
+		Note:      data.Note.ValueString(),
+	}
+
+	created, err := r.Client.CreateHotspotOperator(ctx, operator)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating hotspot operator", err.Error())
+		return
+	}
+
+	r.syncState(&data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotOperatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data hotspotOperatorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	operator, err := r.Client.GetHotspotOperator(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading hotspot operator", err.Error())
+		return
+	}
+
+	r.syncState(&data, operator)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotOperatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data hotspotOperatorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	operator := &client.HotspotOperator{
+		ID:        data.ID.ValueString(),
+		Name:      data.Name.ValueString(),
+		XPassword: data.Password.ValueString(),
+		Note:      data.Note.ValueString(),
+	}
+
+	updated, err := r.Client.UpdateHotspotOperator(ctx, data.ID.ValueString(), operator)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating hotspot operator", err.Error())
+		return
+	}
+
+	r.syncState(&data, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotOperatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data hotspotOperatorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.Client.DeleteHotspotOperator(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting hotspot operator", err.Error())
+		return
+	}
+}
+
+func (r *hotspotOperatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *hotspotOperatorResource) syncState(data *hotspotOperatorResourceModel, operator *client.HotspotOperator) {
+	data.ID = types.StringValue(operator.ID)
+	data.Name = types.StringValue(operator.Name)
+	data.Note = utils.StringToValue(operator.Note)
+
+	// The controller may not echo the password; keep the configured value.
+	if operator.XPassword != "" {
+		data.Password = types.StringValue(operator.XPassword)
+	}
+}
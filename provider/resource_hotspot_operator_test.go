@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHotspotOperatorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHotspotOperatorResourceConfig("frontdesk", "Lobby staff"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "name", "frontdesk"),
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "note", "Lobby staff"),
+				),
+			},
+			{
+				Config: testAccHotspotOperatorResourceConfig("frontdesk", "Night shift"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_operator.test", "note", "Night shift"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHotspotOperatorResourceConfig(name, note string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_hotspot_operator" "test" {
+  name     = %[2]q
+  password = "operator-password"
+  note     = %[3]q
+}
+`, getProviderConfig(), name, note)
+}
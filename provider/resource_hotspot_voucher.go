@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ resource.Resource = &hotspotVoucherResource{}
+var _ resource.ResourceWithImportState = &hotspotVoucherResource{}
+var _ resource.ResourceWithIdentity = &hotspotVoucherResource{}
+
+func NewHotspotVoucherResource() resource.Resource {
+	return &hotspotVoucherResource{}
+}
+
+type hotspotVoucherResource struct {
+	BaseResource
+}
+
+type hotspotVoucherResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Quantity      types.Int64  `tfsdk:"quantity"`
+	Duration      types.Int64  `tfsdk:"duration"`
+	Quota         types.Int64  `tfsdk:"quota"`
+	UpLimitKbps   types.Int64  `tfsdk:"up_limit_kbps"`
+	DownLimitKbps types.Int64  `tfsdk:"down_limit_kbps"`
+	DataLimitMB   types.Int64  `tfsdk:"data_limit_mb"`
+	Note          types.String `tfsdk:"note"`
+	Codes         types.List   `tfsdk:"codes"`
+	VoucherIDs    types.List   `tfsdk:"voucher_ids"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *hotspotVoucherResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hotspot_voucher"
+}
+
+func (r *hotspotVoucherResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a batch of UniFi hotspot vouchers. Vouchers cannot be modified, so any change creates a new batch. " +
+			"The batch is removed from state once all of its vouchers have expired or been used up.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the first voucher of the batch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of vouchers to create. Defaults to 1.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "How long a voucher grants access once redeemed, in minutes.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"quota": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "How many times each voucher can be redeemed. 0 means unlimited. Defaults to 1.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"up_limit_kbps": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Upload bandwidth limit in Kbps.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"down_limit_kbps": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Download bandwidth limit in Kbps.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"data_limit_mb": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Data transfer limit in megabytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"note": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A note attached to every voucher of the batch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"codes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The voucher codes.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"voucher_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the vouchers.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *hotspotVoucherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data hotspotVoucherResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	batch := &client.VoucherBatch{
+		Count:    int(data.Quantity.ValueInt64()),
+		Duration: int(data.Duration.ValueInt64()),
+		Quota:    int(data.Quota.ValueInt64()),
+		Note:     data.Note.ValueString(),
+		Up:       utils.Int64Ptr(data.UpLimitKbps),
+		Down:     utils.Int64Ptr(data.DownLimitKbps),
+		Bytes:    utils.Int64Ptr(data.DataLimitMB),
+	}
+
+	vouchers, err := r.Client.CreateVouchers(ctx, batch)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating hotspot vouchers", err.Error())
+		return
+	}
+	if len(vouchers) == 0 {
+		resp.Diagnostics.AddError("Error creating hotspot vouchers", "The controller did not return the created vouchers")
+		return
+	}
+
+	data.ID = types.StringValue(vouchers[0].ID)
+	r.syncState(ctx, &data, vouchers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotVoucherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data hotspotVoucherResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var ids []string
+	resp.Diagnostics.Append(data.VoucherIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := r.Client.ListVouchers(ctx, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading hotspot vouchers", err.Error())
+		return
+	}
+	vouchers := voucherBatch(all, data.ID.ValueString(), ids)
+
+	if len(vouchers) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.syncState(ctx, &data, vouchers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotVoucherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement; there is nothing to update in place.
+	var data hotspotVoucherResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *hotspotVoucherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data hotspotVoucherResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	var ids []string
+	resp.Diagnostics.Append(data.VoucherIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range ids {
+		if err := r.Client.DeleteVoucher(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Error deleting hotspot voucher", err.Error())
+			return
+		}
+	}
+}
+
+func (r *hotspotVoucherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *hotspotVoucherResource) syncState(ctx context.Context, data *hotspotVoucherResourceModel, vouchers []client.Voucher) {
+	codes := make([]string, len(vouchers))
+	ids := make([]string, len(vouchers))
+	for i, v := range vouchers {
+		codes[i] = v.Code
+		ids[i] = v.ID
+	}
+
+	data.Codes, _ = types.ListValueFrom(ctx, types.StringType, codes)
+	data.VoucherIDs, _ = types.ListValueFrom(ctx, types.StringType, ids)
+
+	// Imported and listed batches have no configuration to keep; take the
+	// settings from the vouchers themselves.
+	if data.Duration.IsNull() {
+		v := vouchers[0]
+		data.Quantity = types.Int64Value(int64(len(vouchers)))
+		data.Duration = utils.Int64Value(v.Duration)
+		data.Quota = utils.Int64Value(v.Quota)
+		data.UpLimitKbps = utils.Int64Value(v.QosRateMaxUp)
+		data.DownLimitKbps = utils.Int64Value(v.QosRateMaxDown)
+		data.DataLimitMB = utils.Int64Value(v.QosUsageQuota)
+		data.Note = utils.StringToValue(v.Note)
+	}
+}
+
+// voucherBatch picks the vouchers of a batch out of all vouchers on the site.
+// A batch created by the resource is tracked by its voucher IDs. An imported
+// batch is found from the voucher named by the resource ID, or by the
+// creation time that earlier versions used as the ID, and extends to the
+// vouchers created with it.
+func voucherBatch(all []client.Voucher, id string, voucherIDs []string) []client.Voucher {
+	var batch []client.Voucher
+	if len(voucherIDs) > 0 {
+		wanted := make(map[string]bool, len(voucherIDs))
+		for _, id := range voucherIDs {
+			wanted[id] = true
+		}
+		for _, v := range all {
+			if wanted[v.ID] {
+				batch = append(batch, v)
+			}
+		}
+		return batch
+	}
+
+	for _, anchor := range all {
+		if anchor.ID != id && strconv.FormatInt(anchor.CreateTime, 10) != id {
+			continue
+		}
+		for _, v := range all {
+			if sameVoucherBatch(&anchor, &v) {
+				batch = append(batch, v)
+			}
+		}
+		return batch
+	}
+	return nil
+}
+
+// groupVoucherBatches splits the vouchers on the site into batches. Batches
+// created in the same second with identical settings cannot be told apart
+// and are reported as one.
+func groupVoucherBatches(all []client.Voucher) [][]client.Voucher {
+	var batches [][]client.Voucher
+	grouped := make([]bool, len(all))
+	for i := range all {
+		if grouped[i] {
+			continue
+		}
+		var batch []client.Voucher
+		for j := i; j < len(all); j++ {
+			if !grouped[j] && sameVoucherBatch(&all[i], &all[j]) {
+				grouped[j] = true
+				batch = append(batch, all[j])
+			}
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// sameVoucherBatch reports whether two vouchers were created together: at
+// the same second and with the same settings.
+func sameVoucherBatch(a, b *client.Voucher) bool {
+	return a.CreateTime == b.CreateTime &&
+		a.Note == b.Note &&
+		equalIntPtr(a.Duration, b.Duration) &&
+		equalIntPtr(a.Quota, b.Quota) &&
+		equalIntPtr(a.QosRateMaxUp, b.QosRateMaxUp) &&
+		equalIntPtr(a.QosRateMaxDown, b.QosRateMaxDown) &&
+		equalIntPtr(a.QosUsageQuota, b.QosUsageQuota)
+}
+
+func equalIntPtr(a, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHotspotVoucherResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHotspotVoucherResourceConfig(3, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_hotspot_voucher.test", "id"),
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test", "codes.#", "3"),
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test", "voucher_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHotspotVoucherResource_sameSecond(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Identical batches created in parallel usually share a creation
+				// second; each must still track only its own vouchers.
+				Config: testAccHotspotVoucherResourceSameSecondConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test.0", "voucher_ids.#", "2"),
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test.0", "codes.#", "2"),
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test.1", "voucher_ids.#", "2"),
+					resource.TestCheckResourceAttr("unifi_hotspot_voucher.test.1", "codes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHotspotVoucherResourceConfig(count, duration int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_hotspot_voucher" "test" {
+  quantity      = %[2]d
+  duration      = %[3]d
+  quota         = 1
+  data_limit_mb = 1024
+  note          = "terraform acceptance test"
+}
+`, getProviderConfig(), count, duration)
+}
+
+func testAccHotspotVoucherResourceSameSecondConfig() string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_hotspot_voucher" "test" {
+  count    = 2
+  quantity = 2
+  duration = 60
+  note     = "terraform acceptance test"
+}
+`, getProviderConfig())
+}
@@ -1,20 +1,27 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
+	"fmt"
+	"net/netip"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &networkResource{}
 var _ resource.ResourceWithImportState = &networkResource{}
+var _ resource.ResourceWithModifyPlan = &networkResource{}
+var _ resource.ResourceWithIdentity = &networkResource{}
 
 func NewNetworkResource() resource.Resource {
 	return &networkResource{}
@@ -25,18 +32,24 @@ type networkResource struct {
 }
 
 type networkResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Purpose types.String `tfsdk:"purpose"`
-	VlanID  types.Int64  `tfsdk:"vlan_id"`
-	Subnet  types.String `tfsdk:"subnet"`
+	ID      types.String    `tfsdk:"id"`
+	Name    types.String    `tfsdk:"name"`
+	Purpose types.String    `tfsdk:"purpose"`
+	VlanID  types.Int64     `tfsdk:"vlan_id"`
+	Subnet  utils.CIDRValue `tfsdk:"subnet"`
+
+	IgnoreConflicts  types.Bool `tfsdk:"ignore_conflicts"`
+	AdoptExisting    types.Bool `tfsdk:"adopt_existing"`
+	WaitForProvision types.Bool `tfsdk:"wait_for_provision"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *networkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
 
-func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *networkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi network (VLAN).",
 		Attributes: map[string]schema.Attribute{
@@ -61,12 +74,33 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"vlan_id": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "The VLAN ID for the network.",
+				MarkdownDescription: "The VLAN ID for the network. Setting it enables VLAN tagging and removing it disables tagging; an adopted network keeps its existing tagging when it is unset.",
+				Validators: []validator.Int64{
+					validators.VLANID(),
+				},
 			},
 			"subnet": schema.StringAttribute{
+				CustomType:          utils.CIDRType{},
 				Optional:            true,
-				MarkdownDescription: "The subnet for the network (CIDR format).",
+				MarkdownDescription: "The subnet for the network, as the gateway address and prefix length (e.g., 192.168.1.1/24). When unset, the network keeps the subnet the controller assigns.",
+				Validators: []validator.String{
+					validators.GatewayCIDR(),
+				},
+			},
+			"ignore_conflicts": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Skip the plan-time check that no other network uses the same VLAN ID or an overlapping subnet. Set this when the overlap is intentional.",
 			},
+			"adopt_existing":     adoptExistingAttribute("network"),
+			"wait_for_provision": waitForProvisionAttribute("all devices on the site"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -78,22 +112,36 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	vlanEnabled := true
-	network := &client.Network{
-		Name:    data.Name.ValueString(),
-		Purpose: utils.StringOrEmpty(data.Purpose),
-		NetworkVLAN: client.NetworkVLAN{
-			VLAN:        utils.Int64Ptr(data.VlanID),
-			VLANEnabled: &vlanEnabled,
-			IPSubnet:    data.Subnet.ValueString(),
-		},
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	existing, err := findAdoptable(ctx, data.AdoptExisting, data.Name.ValueString(), r.Client.ListNetworks, func(n *client.Network) string { return n.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing networks", err.Error())
+		return
 	}
 
+	// An adopted network keeps whatever the configuration leaves unset, so
+	// adopting an untagged network does not turn on VLAN tagging.
+	network := existing
+	if network == nil {
+		network = &client.Network{Name: data.Name.ValueString()}
+	}
+	if purpose := utils.StringOrEmpty(data.Purpose); purpose != "" {
+		network.Purpose = purpose
+	}
+	network.NetworkVLAN = expandNetworkVLAN(&data, network.NetworkVLAN)
+
 	if network.Purpose == "" {
 		network.Purpose = "corporate"
 	}
 
-	created, err := r.Client.CreateNetwork(ctx, network)
+	var created *client.Network
+	if existing != nil {
+		created, err = r.Client.UpdateNetwork(ctx, existing.ID, network)
+	} else {
+		created, err = r.Client.CreateNetwork(ctx, network)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating network", err.Error())
 		return
@@ -103,6 +151,12 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	data.Purpose = types.StringValue(created.Purpose)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics)
 }
 
 func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -112,37 +166,42 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	network, err := r.Client.GetNetwork(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading network", err.Error())
 		return
 	}
 
-	data.Name = types.StringValue(network.Name)
-	data.Purpose = types.StringValue(network.Purpose)
-	data.VlanID = utils.Int64Value(network.VLAN)
-	data.Subnet = types.StringValue(network.IPSubnet)
-
+	r.syncState(&data, network)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data networkResourceModel
+	var data, state networkResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	vlanEnabled := true
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	network := &client.Network{
-		ID:      data.ID.ValueString(),
-		Name:    data.Name.ValueString(),
-		Purpose: data.Purpose.ValueString(),
-		NetworkVLAN: client.NetworkVLAN{
-			VLAN:        utils.Int64Ptr(data.VlanID),
-			VLANEnabled: &vlanEnabled,
-			IPSubnet:    data.Subnet.ValueString(),
-		},
+		ID:          data.ID.ValueString(),
+		Name:        data.Name.ValueString(),
+		Purpose:     data.Purpose.ValueString(),
+		NetworkVLAN: expandNetworkVLAN(&data, client.NetworkVLAN{}),
+	}
+	// Removing vlan_id untags the network rather than leaving the old VLAN
+	// in place.
+	if data.VlanID.IsNull() && !state.VlanID.IsNull() {
+		vlanEnabled := false
+		network.VLANEnabled = &vlanEnabled
 	}
 
 	updated, err := r.Client.UpdateNetwork(ctx, data.ID.ValueString(), network)
@@ -151,12 +210,14 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	data.Name = types.StringValue(updated.Name)
-	data.Purpose = types.StringValue(updated.Purpose)
-	data.VlanID = utils.Int64Value(updated.VLAN)
-	data.Subnet = types.StringValue(updated.IPSubnet)
-
+	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics)
 }
 
 func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -166,12 +227,136 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if network, err := r.Client.GetNetwork(ctx, data.ID.ValueString()); err == nil && isBuiltIn(network.AttrNoDelete, network.AttrHiddenID) {
+		warnBuiltInNotDeleted(&resp.Diagnostics, "network", network.Name)
+		return
+	}
+
 	if err := r.Client.DeleteNetwork(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting network", err.Error())
 		return
 	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics)
+}
+
+// ModifyPlan rejects a VLAN ID or subnet that another network on the site
+// already uses. Only new or changed values are checked, and the check is
+// skipped when the networks cannot be listed.
+func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.Client == nil {
+		return
+	}
+
+	var plan networkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.IgnoreConflicts.ValueBool() {
+		return
+	}
+
+	var state networkResourceModel
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	checkVLAN := !plan.VlanID.IsNull() && !plan.VlanID.IsUnknown() && (creating || !state.VlanID.Equal(plan.VlanID))
+	checkSubnet := !plan.Subnet.IsNull() && !plan.Subnet.IsUnknown() && (creating || !state.Subnet.Equal(plan.Subnet))
+	if !checkVLAN && !checkSubnet {
+		return
+	}
+
+	networks, err := r.Client.ListNetworks(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, n := range networks {
+		if n.ID == plan.ID.ValueString() || (creating && plan.AdoptExisting.ValueBool() && n.Name == plan.Name.ValueString()) {
+			continue
+		}
+		if checkVLAN && n.VLAN != nil && int64(*n.VLAN) == plan.VlanID.ValueInt64() && (n.VLANEnabled == nil || *n.VLANEnabled) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("vlan_id"),
+				"Duplicate VLAN ID",
+				fmt.Sprintf("VLAN %d is already used by network %q (%s). Set ignore_conflicts = true if this is intentional.", *n.VLAN, n.Name, n.ID),
+			)
+		}
+		if checkSubnet && subnetsOverlap(plan.Subnet.ValueString(), n.IPSubnet) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subnet"),
+				"Overlapping Subnet",
+				fmt.Sprintf("%s overlaps the subnet %s of network %q (%s). Set ignore_conflicts = true if this is intentional.", plan.Subnet.ValueString(), n.IPSubnet, n.Name, n.ID),
+			)
+		}
+	}
 }
 
 func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// expandNetworkVLAN applies the configured VLAN ID and subnet to vlan.
+// Unset attributes leave vlan as it is, and VLAN tagging is only enabled
+// along with a VLAN ID.
+func expandNetworkVLAN(data *networkResourceModel, vlan client.NetworkVLAN) client.NetworkVLAN {
+	if !data.VlanID.IsNull() {
+		vlanEnabled := true
+		vlan.VLAN = utils.Int64Ptr(data.VlanID)
+		vlan.VLANEnabled = &vlanEnabled
+	}
+	if !data.Subnet.IsNull() {
+		vlan.IPSubnet = data.Subnet.ValueString()
+	}
+	return vlan
+}
+
+// syncState copies network into data. vlan_id and subnet are only tracked
+// when configured, so an adopted network does not report values the
+// configuration leaves unset. An import, which has no name yet, takes both.
+func (r *networkResource) syncState(data *networkResourceModel, network *client.Network) {
+	imported := data.Name.IsNull()
+
+	data.ID = types.StringValue(network.ID)
+	data.Name = types.StringValue(network.Name)
+	data.Purpose = types.StringValue(network.Purpose)
+	if imported || !data.VlanID.IsNull() {
+		data.VlanID = types.Int64Null()
+		if network.VLANEnabled == nil || *network.VLANEnabled {
+			data.VlanID = utils.Int64Value(network.VLAN)
+		}
+	}
+	if imported || !data.Subnet.IsNull() {
+		data.Subnet = utils.CIDRValue{StringValue: types.StringNull()}
+		if network.IPSubnet != "" {
+			data.Subnet = utils.NewCIDRValue(network.IPSubnet)
+		}
+	}
+}
+
+// subnetsOverlap reports whether two subnets, in CIDR or gateway-host form,
+// share any address. Unparseable subnets never overlap.
+func subnetsOverlap(a, b string) bool {
+	pa, errA := netip.ParsePrefix(a)
+	pb, errB := netip.ParsePrefix(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return pa.Masked().Overlaps(pb.Masked())
+}
+
+// subnetContains reports whether every address of inner lies within outer.
+func subnetContains(outer, inner string) bool {
+	po, errO := netip.ParsePrefix(outer)
+	pi, errI := netip.ParsePrefix(inner)
+	if errO != nil || errI != nil {
+		return false
+	}
+	return pi.Bits() >= po.Bits() && po.Masked().Contains(pi.Addr())
 }
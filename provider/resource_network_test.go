@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -30,11 +31,181 @@ func TestAccNetworkResource(t *testing.T) {
 					resource.TestCheckResourceAttr("unifi_network.test", "subnet", "192.168.201.1/24"),
 				),
 			},
+			// Removing vlan_id untags the network
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name    = "Updated Network CI"
+  subnet  = "192.168.201.1/24"
+  purpose = "corporate"
+}
+`, getProviderConfig()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unifi_network.test", "vlan_id"),
+					resource.TestCheckResourceAttr("unifi_network.test", "subnet", "192.168.201.1/24"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccNetworkResource_adoptUntagged(t *testing.T) {
+	// The built-in Default network is untagged; adopting it without vlan_id
+	// or subnet must not enable tagging, change its subnet or leave a diff.
+	config := fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name           = "Default"
+  adopt_existing = true
+}
+`, getProviderConfig())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_network.test", "id"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "vlan_id"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "subnet"),
+				),
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkResourceConfig("Invalid Network CI", 5000, "192.168.202.1/24"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid VLAN ID`),
+			},
+			{
+				Config:      testAccNetworkResourceConfig("Invalid Network CI", 202, "10.0.0/24"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Subnet`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_conflicts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkResourceConfig("Conflict Network CI", 203, "192.168.203.1/24"),
+			},
+			{
+				Config: testAccNetworkResourceConfig("Conflict Network CI", 203, "192.168.203.1/24") + `
+resource "unifi_network" "duplicate" {
+  name    = "Duplicate Network CI"
+  vlan_id = 203
+  subnet  = "192.168.204.1/24"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate VLAN ID`),
+			},
+			{
+				Config: testAccNetworkResourceConfig("Conflict Network CI", 203, "192.168.203.1/24") + `
+resource "unifi_network" "duplicate" {
+  name    = "Overlapping Network CI"
+  vlan_id = 204
+  subnet  = "192.168.203.129/25"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Overlapping Subnet`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_timeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name    = "Timeouts Network CI"
+  vlan_id = 205
+  subnet  = "192.168.205.1/24"
+
+  timeouts {
+    create = "20m"
+    delete = "5m"
+  }
+}
+`, getProviderConfig()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "timeouts.create", "20m"),
+					resource.TestCheckResourceAttr("unifi_network.test", "timeouts.delete", "5m"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name    = "Timeouts Network CI"
+  vlan_id = 205
+  subnet  = "192.168.205.1/24"
+
+  timeouts {
+    create = "soon"
+  }
+}
+`, getProviderConfig()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_waitForProvision(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "unifi_network" "test" {
+  name               = "Provision Network CI"
+  vlan_id            = 206
+  subnet             = "192.168.206.1/24"
+  wait_for_provision = true
+}
+`, getProviderConfig()),
+				Check: resource.TestCheckResourceAttr("unifi_network.test", "wait_for_provision", "true"),
+			},
+		},
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
 	// Add environment variable checks if necessary
 }
@@ -1,21 +1,26 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &portForwardResource{}
 var _ resource.ResourceWithImportState = &portForwardResource{}
+var _ resource.ResourceWithIdentity = &portForwardResource{}
 
 func NewPortForwardResource() resource.Resource {
 	return &portForwardResource{}
@@ -26,22 +31,24 @@ type portForwardResource struct {
 }
 
 type portForwardResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
-	Protocol      types.String `tfsdk:"protocol"`
-	Src           types.String `tfsdk:"src"`
-	DstPort       types.String `tfsdk:"dst_port"`
-	Fwd           types.String `tfsdk:"fwd"`
-	FwdPort       types.String `tfsdk:"fwd_port"`
-	PfwdInterface types.String `tfsdk:"pfwd_interface"`
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Enabled       types.Bool           `tfsdk:"enabled"`
+	Protocol      types.String         `tfsdk:"protocol"`
+	Src           utils.CIDRValue      `tfsdk:"src"`
+	DstPort       types.String         `tfsdk:"dst_port"`
+	Fwd           utils.IPAddressValue `tfsdk:"fwd"`
+	FwdPort       types.String         `tfsdk:"fwd_port"`
+	PfwdInterface types.String         `tfsdk:"pfwd_interface"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *portForwardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_port_forward"
 }
 
-func (r *portForwardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *portForwardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi port forwarding rule.",
 		Attributes: map[string]schema.Attribute{
@@ -69,24 +76,38 @@ func (r *portForwardResource) Schema(_ context.Context, _ resource.SchemaRequest
 				MarkdownDescription: "The protocol for the port forwarding rule (e.g., tcp, udp, tcp_udp).",
 			},
 			"src": schema.StringAttribute{
+				CustomType:          utils.CIDRType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The source IP or network.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.Any(stringvalidator.OneOf("any"), validators.IPOrCIDR()),
+				},
 			},
 			"dst_port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The destination port or port range.",
+				Validators: []validator.String{
+					validators.PortRange(),
+				},
 			},
 			"fwd": schema.StringAttribute{
+				CustomType:          utils.IPAddressType{},
 				Required:            true,
 				MarkdownDescription: "The forward-to IP address.",
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
 			},
 			"fwd_port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The forward-to port or port range.",
+				Validators: []validator.String{
+					validators.PortRange(),
+				},
 			},
 			"pfwd_interface": schema.StringAttribute{
 				Optional:            true,
@@ -97,6 +118,14 @@ func (r *portForwardResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -107,6 +136,9 @@ func (r *portForwardResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	forward := &client.PortForward{
 		Name:          data.Name.ValueString(),
 		Enabled:       utils.BoolPtr(data.Enabled),
@@ -133,6 +165,7 @@ func (r *portForwardResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *portForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -142,6 +175,9 @@ func (r *portForwardResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	forward, err := r.Client.GetPortForward(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading port forward", err.Error())
@@ -150,6 +186,7 @@ func (r *portForwardResource) Read(ctx context.Context, req resource.ReadRequest
 
 	r.syncState(&data, forward)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -159,6 +196,9 @@ func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	forward := &client.PortForward{
 		ID:            data.ID.ValueString(),
 		Name:          data.Name.ValueString(),
@@ -179,6 +219,7 @@ func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateReq
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *portForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -188,6 +229,9 @@ func (r *portForwardResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeletePortForward(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting port forward", err.Error())
 		return
@@ -195,7 +239,7 @@ func (r *portForwardResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *portForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *portForwardResource) syncState(data *portForwardResourceModel, forward *client.PortForward) {
@@ -203,9 +247,9 @@ func (r *portForwardResource) syncState(data *portForwardResourceModel, forward
 	data.Name = types.StringValue(forward.Name)
 	data.Enabled = utils.BoolValue(forward.Enabled)
 	data.Protocol = types.StringValue(forward.Proto)
-	data.Src = types.StringValue(forward.Src)
+	data.Src = utils.NewCIDRValue(forward.Src)
 	data.DstPort = types.StringValue(forward.DstPort)
-	data.Fwd = types.StringValue(forward.Fwd)
+	data.Fwd = utils.NewIPAddressValue(forward.Fwd)
 	data.FwdPort = types.StringValue(forward.FwdPort)
 	data.PfwdInterface = types.StringValue(forward.PfwdInterface)
 }
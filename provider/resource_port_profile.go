@@ -1,19 +1,21 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 )
 
 var _ resource.Resource = &portProfileResource{}
 var _ resource.ResourceWithImportState = &portProfileResource{}
+var _ resource.ResourceWithIdentity = &portProfileResource{}
 
 func NewPortProfileResource() resource.Resource {
 	return &portProfileResource{}
@@ -29,13 +31,16 @@ type portProfileResourceModel struct {
 	NativeNetworkID  types.String `tfsdk:"native_network_id"`
 	TaggedNetworkIDs types.List   `tfsdk:"tagged_network_ids"`
 	Forward          types.String `tfsdk:"forward"`
+
+	WaitForProvision types.Bool     `tfsdk:"wait_for_provision"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *portProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_port_profile"
 }
 
-func (r *portProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *portProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi port profile.",
 		Attributes: map[string]schema.Attribute{
@@ -72,6 +77,15 @@ func (r *portProfileResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_provision": waitForProvisionAttribute("the switches"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -83,6 +97,9 @@ func (r *portProfileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var taggedNetworkIDs []string
 	if !data.TaggedNetworkIDs.IsNull() && !data.TaggedNetworkIDs.IsUnknown() {
 		resp.Diagnostics.Append(data.TaggedNetworkIDs.ElementsAs(ctx, &taggedNetworkIDs, false)...)
@@ -111,6 +128,12 @@ func (r *portProfileResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "usw", "udm")
 }
 
 func (r *portProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -120,6 +143,9 @@ func (r *portProfileResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	profile, err := r.Client.GetPortProfile(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading port profile", err.Error())
@@ -128,6 +154,7 @@ func (r *portProfileResource) Read(ctx context.Context, req resource.ReadRequest
 
 	r.syncState(ctx, &data, profile)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -137,6 +164,9 @@ func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var taggedNetworkIDs []string
 	if !data.TaggedNetworkIDs.IsNull() && !data.TaggedNetworkIDs.IsUnknown() {
 		resp.Diagnostics.Append(data.TaggedNetworkIDs.ElementsAs(ctx, &taggedNetworkIDs, false)...)
@@ -161,6 +191,12 @@ func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateReq
 
 	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "usw", "udm")
 }
 
 func (r *portProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -170,14 +206,19 @@ func (r *portProfileResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeletePortProfile(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting port profile", err.Error())
 		return
 	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "usw", "udm")
 }
 
 func (r *portProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *portProfileResource) syncState(ctx context.Context, data *portProfileResourceModel, profile *client.PortConf) {
@@ -1,21 +1,29 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &radiusProfileResource{}
 var _ resource.ResourceWithImportState = &radiusProfileResource{}
+var _ resource.ResourceWithIdentity = &radiusProfileResource{}
 
 func NewRADIUSProfileResource() resource.Resource {
 	return &radiusProfileResource{}
@@ -29,6 +37,10 @@ type radiusProfileResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	AuthServers types.List   `tfsdk:"auth_servers"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type radiusServerModel struct {
@@ -37,11 +49,36 @@ type radiusServerModel struct {
 	Secret types.String `tfsdk:"secret"`
 }
 
+// radiusAuthServerModel is an auth server of the resource, which unlike the
+// data sources also accepts a write-only secret.
+type radiusAuthServerModel struct {
+	IP              types.String `tfsdk:"ip"`
+	Port            types.Int64  `tfsdk:"port"`
+	Secret          types.String `tfsdk:"secret"`
+	SecretWO        types.String `tfsdk:"secret_wo"`
+	SecretWOVersion types.Int64  `tfsdk:"secret_wo_version"`
+}
+
+var radiusAuthServerAttrTypes = map[string]attr.Type{
+	"ip":                types.StringType,
+	"port":              types.Int64Type,
+	"secret":            types.StringType,
+	"secret_wo":         types.StringType,
+	"secret_wo_version": types.Int64Type,
+}
+
+func (s radiusAuthServerModel) secret() string {
+	if !s.Secret.IsNull() {
+		return s.Secret.ValueString()
+	}
+	return s.SecretWO.ValueString()
+}
+
 func (r *radiusProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_radius_profile"
 }
 
-func (r *radiusProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *radiusProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi RADIUS profile.",
 		Attributes: map[string]schema.Attribute{
@@ -61,19 +98,51 @@ func (r *radiusProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 					Attributes: map[string]schema.Attribute{
 						"ip": schema.StringAttribute{
 							Required: true,
+							Validators: []validator.String{
+								validators.IPAddress(),
+							},
 						},
 						"port": schema.Int64Attribute{
 							Optional: true,
 							Computed: true,
+							Validators: []validator.Int64{
+								validators.Port(),
+							},
 						},
 						"secret": schema.StringAttribute{
-							Required:  true,
-							Sensitive: true,
+							Optional:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The shared secret. It is stored in state; prefer `secret_wo`.",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("secret_wo")),
+							},
+						},
+						"secret_wo": schema.StringAttribute{
+							Optional:            true,
+							WriteOnly:           true,
+							Sensitive:           true,
+							MarkdownDescription: "The shared secret, which is never stored in state. Requires Terraform 1.11 or later. Changes are only applied when `secret_wo_version` changes.",
+						},
+						"secret_wo_version": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Change this value to apply a new `secret_wo`.",
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo")),
+							},
 						},
 					},
 				},
 				Optional: true,
 			},
+			"adopt_existing": adoptExistingAttribute("RADIUS profile"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -85,8 +154,10 @@ func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	var authServers []radiusServerModel
-	resp.Diagnostics.Append(data.AuthServers.ElementsAs(ctx, &authServers, false)...)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	authServers := r.authServers(ctx, &data, req.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -100,16 +171,28 @@ func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateR
 		servers[i] = client.RADIUSServer{
 			IP:      s.IP.ValueString(),
 			Port:    &port,
-			XSecret: s.Secret.ValueString(),
+			XSecret: s.secret(),
 		}
 	}
 
-	profile := &client.RADIUSProfile{
-		Name:        data.Name.ValueString(),
-		AuthServers: servers,
+	existing, err := findAdoptable(ctx, data.AdoptExisting, data.Name.ValueString(), r.Client.ListRADIUSProfiles, func(p *client.RADIUSProfile) string { return p.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing RADIUS profiles", err.Error())
+		return
+	}
+
+	profile := existing
+	if profile == nil {
+		profile = &client.RADIUSProfile{Name: data.Name.ValueString()}
 	}
+	profile.AuthServers = servers
 
-	created, err := r.Client.CreateRADIUSProfile(ctx, profile)
+	var created *client.RADIUSProfile
+	if existing != nil {
+		created, err = r.Client.UpdateRADIUSProfile(ctx, existing.ID, profile)
+	} else {
+		created, err = r.Client.CreateRADIUSProfile(ctx, profile)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating RADIUS profile", err.Error())
 		return
@@ -117,6 +200,7 @@ func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateR
 
 	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *radiusProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -126,6 +210,9 @@ func (r *radiusProfileResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	profile, err := r.Client.GetRADIUSProfile(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading RADIUS profile", err.Error())
@@ -134,6 +221,7 @@ func (r *radiusProfileResource) Read(ctx context.Context, req resource.ReadReque
 
 	r.syncState(ctx, &data, profile)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -143,8 +231,10 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var authServers []radiusServerModel
-	resp.Diagnostics.Append(data.AuthServers.ElementsAs(ctx, &authServers, false)...)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	authServers := r.authServers(ctx, &data, req.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -155,7 +245,7 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 		servers[i] = client.RADIUSServer{
 			IP:      s.IP.ValueString(),
 			Port:    &port,
-			XSecret: s.Secret.ValueString(),
+			XSecret: s.secret(),
 		}
 	}
 
@@ -173,6 +263,7 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 
 	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *radiusProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -182,6 +273,14 @@ func (r *radiusProfileResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if profile, err := r.Client.GetRADIUSProfile(ctx, data.ID.ValueString()); err == nil && isBuiltIn(profile.AttrNoDelete, profile.AttrHiddenID) {
+		warnBuiltInNotDeleted(&resp.Diagnostics, "RADIUS profile", profile.Name)
+		return
+	}
+
 	if err := r.Client.DeleteRADIUSProfile(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting RADIUS profile", err.Error())
 		return
@@ -189,26 +288,53 @@ func (r *radiusProfileResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *radiusProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
+// authServers returns the planned auth servers with secret_wo taken from the
+// configuration, because write-only values never appear in the plan.
+func (r *radiusProfileResource) authServers(ctx context.Context, data *radiusProfileResourceModel, config tfsdk.Config, diags *diag.Diagnostics) []radiusAuthServerModel {
+	var planned, configured []radiusAuthServerModel
+	diags.Append(data.AuthServers.ElementsAs(ctx, &planned, false)...)
+
+	var configList types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("auth_servers"), &configList)...)
+	diags.Append(configList.ElementsAs(ctx, &configured, false)...)
+
+	for i := range planned {
+		if i < len(configured) {
+			planned[i].SecretWO = configured[i].SecretWO
+		}
+	}
+	return planned
+}
+
+// syncState copies profile into data. Servers whose secret was left unset,
+// because secret_wo is used instead, keep it unset.
 func (r *radiusProfileResource) syncState(ctx context.Context, data *radiusProfileResourceModel, profile *client.RADIUSProfile) {
+	var prior []radiusAuthServerModel
+	data.AuthServers.ElementsAs(ctx, &prior, false)
+
 	data.ID = types.StringValue(profile.ID)
 	data.Name = types.StringValue(profile.Name)
 
-	servers := make([]radiusServerModel, len(profile.AuthServers))
+	servers := make([]radiusAuthServerModel, len(profile.AuthServers))
 	for i, s := range profile.AuthServers {
-		servers[i] = radiusServerModel{
-			IP:     types.StringValue(s.IP),
-			Port:   utils.Int64Value(s.Port),
-			Secret: types.StringValue(s.XSecret),
+		servers[i] = radiusAuthServerModel{
+			IP:              types.StringValue(s.IP),
+			Port:            utils.Int64Value(s.Port),
+			Secret:          types.StringValue(s.XSecret),
+			SecretWO:        types.StringNull(),
+			SecretWOVersion: types.Int64Null(),
+		}
+		if i < len(prior) {
+			servers[i].SecretWOVersion = prior[i].SecretWOVersion
+			if prior[i].Secret.IsNull() {
+				servers[i].Secret = types.StringNull()
+			}
 		}
 	}
 
-	newServers, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: map[string]attr.Type{
-		"ip":     types.StringType,
-		"port":   types.Int64Type,
-		"secret": types.StringType,
-	}}, servers)
+	newServers, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: radiusAuthServerAttrTypes}, servers)
 	data.AuthServers = newServers
 }
@@ -0,0 +1,479 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+)
+
+var _ resource.Resource = &settingIPSResource{}
+var _ resource.ResourceWithImportState = &settingIPSResource{}
+var _ resource.ResourceWithIdentity = &settingIPSResource{}
+
+func NewSettingIPSResource() resource.Resource {
+	return &settingIPSResource{}
+}
+
+type settingIPSResource struct {
+	BaseResource
+}
+
+type settingIPSResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	IPSMode              types.String `tfsdk:"ips_mode"`
+	EnabledCategories    types.List   `tfsdk:"enabled_categories"`
+	EnabledNetworkIDs    types.List   `tfsdk:"enabled_network_ids"`
+	Allowlist            types.List   `tfsdk:"allowlist"`
+	SuppressedAlerts     types.List   `tfsdk:"suppressed_alerts"`
+	DPIEnabled           types.Bool   `tfsdk:"dpi_enabled"`
+	AdBlockingEnabled    types.Bool   `tfsdk:"ad_blocking_enabled"`
+	AdBlockingNetworkIDs types.List   `tfsdk:"ad_blocking_network_ids"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ipsAllowlistModel struct {
+	Direction types.String `tfsdk:"direction"`
+	Mode      types.String `tfsdk:"mode"`
+	Value     types.String `tfsdk:"value"`
+}
+
+var ipsAllowlistAttrTypes = map[string]attr.Type{
+	"direction": types.StringType,
+	"mode":      types.StringType,
+	"value":     types.StringType,
+}
+
+type ipsSuppressedAlertModel struct {
+	SignatureID types.Int64  `tfsdk:"signature_id"`
+	Category    types.String `tfsdk:"category"`
+	Tracking    types.List   `tfsdk:"tracking"`
+}
+
+var ipsSuppressedAlertAttrTypes = map[string]attr.Type{
+	"signature_id": types.Int64Type,
+	"category":     types.StringType,
+	"tracking":     types.ListType{ElemType: types.ObjectType{AttrTypes: ipsAllowlistAttrTypes}},
+}
+
+func (r *settingIPSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting_ips"
+}
+
+func (r *settingIPSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the UniFi threat management (IDS/IPS), DPI and ad blocking settings of a site. " +
+			"The setting is a singleton: destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the IPS setting.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ips_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The threat management mode (disabled, ids, ips, ipsInline).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "ids", "ips", "ipsInline"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled_categories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The signature categories to enable (e.g., emerging-malware, emerging-scan, tor).",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled_network_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the networks protected by threat management.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowlist": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The traffic direction to match (src, dst, both).",
+							Validators: []validator.String{
+								stringvalidator.OneOf("src", "dst", "both"),
+							},
+						},
+						"mode": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The kind of value to match (ip, subnet).",
+							Validators: []validator.String{
+								stringvalidator.OneOf("ip", "subnet"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The IP address or subnet to exclude from threat detection.",
+						},
+					},
+				},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Traffic excluded from threat detection.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suppressed_alerts": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"signature_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The ID of the signature to suppress, as shown on the threat alert.",
+						},
+						"category": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The signature category (e.g., emerging-scan).",
+						},
+						"tracking": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"direction": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The traffic direction to match (src, dst, both).",
+										Validators: []validator.String{
+											stringvalidator.OneOf("src", "dst", "both"),
+										},
+									},
+									"mode": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The kind of value to match (ip, subnet).",
+										Validators: []validator.String{
+											stringvalidator.OneOf("ip", "subnet"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The IP address or subnet for which the signature is suppressed.",
+									},
+								},
+							},
+							Optional:            true,
+							MarkdownDescription: "Only suppress the signature for this traffic. When omitted, the signature is suppressed for all traffic.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Signatures that no longer raise alerts.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dpi_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether deep packet inspection is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ad_blocking_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether ad blocking is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ad_blocking_network_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the networks with ad blocking enabled.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *settingIPSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data settingIPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *settingIPSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data settingIPSResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	ips, err := r.Client.GetSettingIPS(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IPS setting", err.Error())
+		return
+	}
+
+	dpi, err := r.Client.GetSettingDPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading DPI setting", err.Error())
+		return
+	}
+
+	r.syncState(ctx, &data, ips, dpi)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *settingIPSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data settingIPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *settingIPSResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Site settings cannot be deleted; removing the resource only drops it from state.
+}
+
+func (r *settingIPSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// apply overlays the configured attributes on the current settings so that
+// attributes left out of the configuration keep their current values.
+func (r *settingIPSResource) apply(ctx context.Context, data *settingIPSResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ips, err := r.Client.GetSettingIPS(ctx)
+	if err != nil {
+		diags.AddError("Error reading IPS setting", err.Error())
+		return diags
+	}
+
+	if !data.IPSMode.IsNull() && !data.IPSMode.IsUnknown() {
+		ips.IPSMode = data.IPSMode.ValueString()
+	}
+	if !data.EnabledCategories.IsNull() && !data.EnabledCategories.IsUnknown() {
+		ips.EnabledCategories = []string{}
+		diags.Append(data.EnabledCategories.ElementsAs(ctx, &ips.EnabledCategories, false)...)
+	}
+	if !data.EnabledNetworkIDs.IsNull() && !data.EnabledNetworkIDs.IsUnknown() {
+		ips.EnabledNetworks = []string{}
+		diags.Append(data.EnabledNetworkIDs.ElementsAs(ctx, &ips.EnabledNetworks, false)...)
+	}
+	if !data.Allowlist.IsNull() && !data.Allowlist.IsUnknown() {
+		var entries []ipsAllowlistModel
+		diags.Append(data.Allowlist.ElementsAs(ctx, &entries, false)...)
+		if ips.Suppression == nil {
+			ips.Suppression = &client.IPSSuppression{}
+		}
+		ips.Suppression.Whitelist = make([]client.IPSWhitelistEntry, len(entries))
+		for i, e := range entries {
+			ips.Suppression.Whitelist[i] = client.IPSWhitelistEntry{
+				Direction: e.Direction.ValueString(),
+				Mode:      e.Mode.ValueString(),
+				Value:     e.Value.ValueString(),
+			}
+		}
+	}
+	if !data.SuppressedAlerts.IsNull() && !data.SuppressedAlerts.IsUnknown() {
+		var alerts []ipsSuppressedAlertModel
+		diags.Append(data.SuppressedAlerts.ElementsAs(ctx, &alerts, false)...)
+		if ips.Suppression == nil {
+			ips.Suppression = &client.IPSSuppression{}
+		}
+		// Keep the generator ID and signature text the controller recorded for
+		// signatures that are already suppressed.
+		existing := map[int64]client.IPSSuppressedAlert{}
+		for _, a := range ips.Suppression.Alerts {
+			existing[a.ID] = a
+		}
+		ips.Suppression.Alerts = make([]client.IPSSuppressedAlert, len(alerts))
+		for i, a := range alerts {
+			var tracking []ipsAllowlistModel
+			diags.Append(a.Tracking.ElementsAs(ctx, &tracking, false)...)
+			alert := client.IPSSuppressedAlert{
+				ID:       a.SignatureID.ValueInt64(),
+				GID:      1,
+				Category: a.Category.ValueString(),
+				Type:     "all",
+				Tracking: []client.IPSWhitelistEntry{},
+			}
+			if prior, ok := existing[alert.ID]; ok {
+				alert.GID = prior.GID
+				alert.Signature = prior.Signature
+			}
+			if len(tracking) > 0 {
+				alert.Type = "track"
+			}
+			for _, t := range tracking {
+				alert.Tracking = append(alert.Tracking, client.IPSWhitelistEntry{
+					Direction: t.Direction.ValueString(),
+					Mode:      t.Mode.ValueString(),
+					Value:     t.Value.ValueString(),
+				})
+			}
+			ips.Suppression.Alerts[i] = alert
+		}
+	}
+	if !data.AdBlockingEnabled.IsNull() && !data.AdBlockingEnabled.IsUnknown() {
+		ips.AdBlockingEnabled = utils.BoolPtr(data.AdBlockingEnabled)
+	}
+	if !data.AdBlockingNetworkIDs.IsNull() && !data.AdBlockingNetworkIDs.IsUnknown() {
+		var networkIDs []string
+		diags.Append(data.AdBlockingNetworkIDs.ElementsAs(ctx, &networkIDs, false)...)
+		ips.AdBlockingConfigurations = make([]client.IPSAdBlockingConfiguration, len(networkIDs))
+		for i, id := range networkIDs {
+			ips.AdBlockingConfigurations[i] = client.IPSAdBlockingConfiguration{NetworkID: id}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	updated, err := r.Client.UpdateSettingIPS(ctx, ips)
+	if err != nil {
+		diags.AddError("Error updating IPS setting", err.Error())
+		return diags
+	}
+
+	dpi, err := r.Client.GetSettingDPI(ctx)
+	if err != nil {
+		diags.AddError("Error reading DPI setting", err.Error())
+		return diags
+	}
+	if !data.DPIEnabled.IsNull() && !data.DPIEnabled.IsUnknown() {
+		dpi.Enabled = utils.BoolPtr(data.DPIEnabled)
+		dpi, err = r.Client.UpdateSettingDPI(ctx, dpi)
+		if err != nil {
+			diags.AddError("Error updating DPI setting", err.Error())
+			return diags
+		}
+	}
+
+	r.syncState(ctx, data, updated, dpi)
+	return diags
+}
+
+func (r *settingIPSResource) syncState(ctx context.Context, data *settingIPSResourceModel, ips *client.SettingIPS, dpi *client.SettingDPI) {
+	data.ID = types.StringValue(ips.ID)
+	data.IPSMode = types.StringValue(ips.IPSMode)
+	data.DPIEnabled = utils.BoolValue(dpi.Enabled)
+	data.AdBlockingEnabled = utils.BoolValue(ips.AdBlockingEnabled)
+
+	categories, _ := types.ListValueFrom(ctx, types.StringType, ips.EnabledCategories)
+	data.EnabledCategories = categories
+
+	networks, _ := types.ListValueFrom(ctx, types.StringType, ips.EnabledNetworks)
+	data.EnabledNetworkIDs = networks
+
+	entries := []ipsAllowlistModel{}
+	if ips.Suppression != nil {
+		for _, e := range ips.Suppression.Whitelist {
+			entries = append(entries, ipsAllowlistModel{
+				Direction: types.StringValue(e.Direction),
+				Mode:      types.StringValue(e.Mode),
+				Value:     types.StringValue(e.Value),
+			})
+		}
+	}
+	allowlist, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipsAllowlistAttrTypes}, entries)
+	data.Allowlist = allowlist
+
+	alerts := []ipsSuppressedAlertModel{}
+	if ips.Suppression != nil {
+		for _, a := range ips.Suppression.Alerts {
+			tracking := types.ListNull(types.ObjectType{AttrTypes: ipsAllowlistAttrTypes})
+			if a.Type == "track" {
+				trackEntries := make([]ipsAllowlistModel, len(a.Tracking))
+				for i, t := range a.Tracking {
+					trackEntries[i] = ipsAllowlistModel{
+						Direction: types.StringValue(t.Direction),
+						Mode:      types.StringValue(t.Mode),
+						Value:     types.StringValue(t.Value),
+					}
+				}
+				tracking, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipsAllowlistAttrTypes}, trackEntries)
+			}
+			alerts = append(alerts, ipsSuppressedAlertModel{
+				SignatureID: types.Int64Value(a.ID),
+				Category:    types.StringValue(a.Category),
+				Tracking:    tracking,
+			})
+		}
+	}
+	suppressed, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipsSuppressedAlertAttrTypes}, alerts)
+	data.SuppressedAlerts = suppressed
+
+	adBlockingIDs := make([]string, len(ips.AdBlockingConfigurations))
+	for i, c := range ips.AdBlockingConfigurations {
+		adBlockingIDs[i] = c.NetworkID
+	}
+	adBlocking, _ := types.ListValueFrom(ctx, types.StringType, adBlockingIDs)
+	data.AdBlockingNetworkIDs = adBlocking
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSettingIPSResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingIPSResourceConfig("ids"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_ips.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ips_mode", "ids"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "allowlist.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppressed_alerts.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppressed_alerts.0.signature_id", "2010935"),
+					resource.TestCheckNoResourceAttr("unifi_setting_ips.test", "suppressed_alerts.0.tracking"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppressed_alerts.1.tracking.0.value", "192.168.1.60"),
+				),
+			},
+			{
+				Config: testAccSettingIPSResourceConfig("disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ips_mode", "disabled"),
+				),
+			},
+			{
+				// Only ips_mode is sent; the controller must keep the rest.
+				Config: testAccSettingIPSResourceModeOnlyConfig("ids"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "ips_mode", "ids"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "enabled_categories.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "allowlist.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_ips.test", "suppressed_alerts.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingIPSResourceConfig(mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_setting_ips" "test" {
+  ips_mode           = %[2]q
+  enabled_categories = ["emerging-malware", "emerging-scan"]
+
+  allowlist = [
+    {
+      direction = "src"
+      mode      = "ip"
+      value     = "192.168.1.50"
+    }
+  ]
+
+  suppressed_alerts = [
+    {
+      signature_id = 2010935
+      category     = "emerging-scan"
+    },
+    {
+      signature_id = 2002910
+      category     = "emerging-scan"
+      tracking = [
+        {
+          direction = "src"
+          mode      = "ip"
+          value     = "192.168.1.60"
+        }
+      ]
+    }
+  ]
+}
+`, getProviderConfig(), mode)
+}
+
+func testAccSettingIPSResourceModeOnlyConfig(mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_setting_ips" "test" {
+  ips_mode = %[2]q
+}
+`, getProviderConfig(), mode)
+}
@@ -1,9 +1,11 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
+	"fmt"
+	"net/netip"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,11 +14,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
 )
 
 var _ resource.Resource = &staticDNSResource{}
 var _ resource.ResourceWithImportState = &staticDNSResource{}
+var _ resource.ResourceWithModifyPlan = &staticDNSResource{}
+var _ resource.ResourceWithValidateConfig = &staticDNSResource{}
+var _ resource.ResourceWithIdentity = &staticDNSResource{}
 
 func NewStaticDNSResource() resource.Resource {
 	return &staticDNSResource{}
@@ -33,13 +39,15 @@ type staticDNSResourceModel struct {
 	RecordType types.String `tfsdk:"record_type"`
 	Enabled    types.Bool   `tfsdk:"enabled"`
 	TTL        types.Int64  `tfsdk:"ttl"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *staticDNSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_static_dns"
 }
 
-func (r *staticDNSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *staticDNSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi static DNS record.",
 		Attributes: map[string]schema.Attribute{
@@ -83,6 +91,14 @@ func (r *staticDNSResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -93,6 +109,9 @@ func (r *staticDNSResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	record := &client.StaticDNS{
 		Key:        data.Key.ValueString(),
 		Value:      data.Value.ValueString(),
@@ -113,6 +132,7 @@ func (r *staticDNSResource) Create(ctx context.Context, req resource.CreateReque
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -122,6 +142,9 @@ func (r *staticDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	record, err := r.Client.GetStaticDNS(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading static DNS", err.Error())
@@ -130,6 +153,7 @@ func (r *staticDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	r.syncState(&data, record)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -139,6 +163,9 @@ func (r *staticDNSResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	record := &client.StaticDNS{
 		ID:         data.ID.ValueString(),
 		Key:        data.Key.ValueString(),
@@ -156,6 +183,7 @@ func (r *staticDNSResource) Update(ctx context.Context, req resource.UpdateReque
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -165,14 +193,56 @@ func (r *staticDNSResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteStaticDNS(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting static DNS", err.Error())
 		return
 	}
 }
 
+// ValidateConfig checks that A and AAAA records point at an address of the
+// matching family.
+func (r *staticDNSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data staticDNSResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Value.IsUnknown() || data.RecordType.IsUnknown() {
+		return
+	}
+
+	recordType := data.RecordType.ValueString()
+	if recordType == "" {
+		recordType = "A"
+	}
+	if recordType != "A" && recordType != "AAAA" {
+		return
+	}
+
+	value := data.Value.ValueString()
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.Is4() != (recordType == "A") {
+		family := "IPv4"
+		if recordType == "AAAA" {
+			family = "IPv6"
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Record Value",
+			fmt.Sprintf("An %s record must point at an %s address, got %q.", recordType, family, value),
+		)
+	}
+}
+
+func (r *staticDNSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.requireVersion(ctx, "unifi_static_dns", "8.0", &resp.Diagnostics)
+}
+
 func (r *staticDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *staticDNSResource) syncState(data *staticDNSResourceModel, record *client.StaticDNS) {
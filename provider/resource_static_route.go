@@ -1,9 +1,10 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,11 +14,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &staticRouteResource{}
 var _ resource.ResourceWithImportState = &staticRouteResource{}
+var _ resource.ResourceWithModifyPlan = &staticRouteResource{}
+var _ resource.ResourceWithIdentity = &staticRouteResource{}
 
 func NewStaticRouteResource() resource.Resource {
 	return &staticRouteResource{}
@@ -28,20 +33,24 @@ type staticRouteResource struct {
 }
 
 type staticRouteResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Enabled  types.Bool   `tfsdk:"enabled"`
-	Type     types.String `tfsdk:"type"`
-	Network  types.String `tfsdk:"network"`
-	Nexthop  types.String `tfsdk:"nexthop"`
-	Distance types.Int64  `tfsdk:"distance"`
+	ID       types.String         `tfsdk:"id"`
+	Name     types.String         `tfsdk:"name"`
+	Enabled  types.Bool           `tfsdk:"enabled"`
+	Type     types.String         `tfsdk:"type"`
+	Network  utils.CIDRValue      `tfsdk:"network"`
+	Nexthop  utils.IPAddressValue `tfsdk:"nexthop"`
+	Distance types.Int64          `tfsdk:"distance"`
+
+	IgnoreConflicts types.Bool `tfsdk:"ignore_conflicts"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *staticRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_static_route"
 }
 
-func (r *staticRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *staticRouteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi static route.",
 		Attributes: map[string]schema.Attribute{
@@ -73,16 +82,24 @@ func (r *staticRouteResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"network": schema.StringAttribute{
+				CustomType:          utils.CIDRType{},
 				Required:            true,
 				MarkdownDescription: "The destination network in CIDR format.",
+				Validators: []validator.String{
+					validators.CIDR(),
+				},
 			},
 			"nexthop": schema.StringAttribute{
+				CustomType:          utils.IPAddressType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The next hop IP address.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
 			},
 			"distance": schema.Int64Attribute{
 				Optional:            true,
@@ -92,6 +109,18 @@ func (r *staticRouteResource) Schema(_ context.Context, _ resource.SchemaRequest
 					int64validator.Between(1, 255),
 				},
 			},
+			"ignore_conflicts": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Skip the plan-time check that the destination does not lie within the subnet of a network on the site. Set this when the overlap is intentional.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -103,6 +132,9 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	route := &client.Routing{
 		Name:                data.Name.ValueString(),
 		Enabled:             utils.BoolPtr(data.Enabled),
@@ -124,6 +156,7 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -133,6 +166,9 @@ func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	route, err := r.Client.GetStaticRoute(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading static route", err.Error())
@@ -141,6 +177,7 @@ func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest
 
 	r.syncState(&data, route)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -150,6 +187,9 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	route := &client.Routing{
 		ID:                  data.ID.ValueString(),
 		Name:                data.Name.ValueString(),
@@ -168,6 +208,7 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -177,14 +218,56 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteStaticRoute(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting static route", err.Error())
 		return
 	}
 }
 
+// ModifyPlan rejects a destination that lies within the subnet of a network
+// on the site, which would divert traffic meant for that network. Broader
+// routes are fine, as the network's own route is more specific. The check is
+// skipped when the networks cannot be listed.
+func (r *staticRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.Client == nil {
+		return
+	}
+
+	var plan staticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.IgnoreConflicts.ValueBool() || plan.Network.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state staticRouteResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Network.Equal(plan.Network) {
+			return
+		}
+	}
+
+	networks, err := r.Client.ListNetworks(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, n := range networks {
+		if subnetContains(n.IPSubnet, plan.Network.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("network"),
+				"Route Overlaps Network",
+				fmt.Sprintf("%s lies within the subnet %s of network %q (%s). Set ignore_conflicts = true if this is intentional.", plan.Network.ValueString(), n.IPSubnet, n.Name, n.ID),
+			)
+		}
+	}
+}
+
 func (r *staticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *staticRouteResource) syncState(data *staticRouteResourceModel, route *client.Routing) {
@@ -192,7 +275,7 @@ func (r *staticRouteResource) syncState(data *staticRouteResourceModel, route *c
 	data.Name = types.StringValue(route.Name)
 	data.Enabled = utils.BoolValue(route.Enabled)
 	data.Type = types.StringValue(route.Type)
-	data.Network = types.StringValue(route.StaticRouteNetwork)
-	data.Nexthop = types.StringValue(route.StaticRouteNexthop)
+	data.Network = utils.NewCIDRValue(route.StaticRouteNetwork)
+	data.Nexthop = utils.NewIPAddressValue(route.StaticRouteNexthop)
 	data.Distance = utils.Int64Value(route.StaticRouteDistance)
 }
@@ -2,20 +2,25 @@ package provider
 
 import (
 	"context"
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &trafficRuleResource{}
 var _ resource.ResourceWithImportState = &trafficRuleResource{}
+var _ resource.ResourceWithModifyPlan = &trafficRuleResource{}
+var _ resource.ResourceWithIdentity = &trafficRuleResource{}
 
 func NewTrafficRuleResource() resource.Resource {
 	return &trafficRuleResource{}
@@ -32,13 +37,18 @@ type trafficRuleResourceModel struct {
 	Action         types.String `tfsdk:"action"`
 	MatchingTarget types.String `tfsdk:"matching_target"`
 	Description    types.String `tfsdk:"description"`
+	AppIDs         types.List   `tfsdk:"app_ids"`
+	AppCategoryIDs types.List   `tfsdk:"app_category_ids"`
+	Regions        types.List   `tfsdk:"regions"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *trafficRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_traffic_rule"
 }
 
-func (r *trafficRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *trafficRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi traffic management rule (v2 API).",
 		Attributes: map[string]schema.Attribute{
@@ -81,6 +91,32 @@ func (r *trafficRuleResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the DPI applications matched by an APP rule. Use the `unifi_dpi_app` data source to look them up.",
+			},
+			"app_category_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the DPI categories matched by an APP rule. Use the `unifi_dpi_category` data source to look them up.",
+			},
+			"regions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The ISO 3166-1 alpha-2 codes of the regions matched by a REGION rule (e.g., US, DE). Use the `unifi_regions` data source to list the supported codes.",
+				Validators: []validator.List{
+					validators.Regions(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -92,6 +128,9 @@ func (r *trafficRuleResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	rule := &client.TrafficRule{
 		Name:           data.Name.ValueString(),
 		Enabled:        utils.BoolPtr(data.Enabled),
@@ -99,6 +138,7 @@ func (r *trafficRuleResource) Create(ctx context.Context, req resource.CreateReq
 		MatchingTarget: data.MatchingTarget.ValueString(),
 		Description:    data.Description.ValueString(),
 	}
+	r.expandMatches(ctx, &data, rule)
 
 	created, err := r.Client.CreateTrafficRule(ctx, rule)
 	if err != nil {
@@ -106,8 +146,9 @@ func (r *trafficRuleResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	r.syncState(&data, created)
+	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *trafficRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -117,14 +158,18 @@ func (r *trafficRuleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	rule, err := r.Client.GetTrafficRule(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading traffic rule", err.Error())
 		return
 	}
 
-	r.syncState(&data, rule)
+	r.syncState(ctx, &data, rule)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *trafficRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -134,6 +179,9 @@ func (r *trafficRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	rule := &client.TrafficRule{
 		ID:             data.ID.ValueString(),
 		Name:           data.Name.ValueString(),
@@ -142,6 +190,7 @@ func (r *trafficRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		MatchingTarget: data.MatchingTarget.ValueString(),
 		Description:    data.Description.ValueString(),
 	}
+	r.expandMatches(ctx, &data, rule)
 
 	updated, err := r.Client.UpdateTrafficRule(ctx, data.ID.ValueString(), rule)
 	if err != nil {
@@ -149,8 +198,9 @@ func (r *trafficRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	r.syncState(&data, updated)
+	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *trafficRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -160,17 +210,27 @@ func (r *trafficRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteTrafficRule(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting traffic rule", err.Error())
 		return
 	}
 }
 
+func (r *trafficRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.requireVersion(ctx, "unifi_traffic_rule", "7.0", &resp.Diagnostics)
+}
+
 func (r *trafficRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
-func (r *trafficRuleResource) syncState(data *trafficRuleResourceModel, rule *client.TrafficRule) {
+func (r *trafficRuleResource) syncState(ctx context.Context, data *trafficRuleResourceModel, rule *client.TrafficRule) {
 	data.ID = types.StringValue(rule.ID)
 
 	// Handle Name: if API returns empty, keep existing if it exists
@@ -191,4 +251,31 @@ func (r *trafficRuleResource) syncState(data *trafficRuleResourceModel, rule *cl
 	} else if data.Description.IsNull() || data.Description.IsUnknown() {
 		data.Description = types.StringNull()
 	}
+
+	if len(rule.AppIDs) > 0 || !data.AppIDs.IsNull() {
+		data.AppIDs, _ = types.ListValueFrom(ctx, types.Int64Type, append([]int{}, rule.AppIDs...))
+	}
+	if len(rule.AppCategoryIDs) > 0 || !data.AppCategoryIDs.IsNull() {
+		data.AppCategoryIDs, _ = types.ListValueFrom(ctx, types.StringType, append([]string{}, rule.AppCategoryIDs...))
+	}
+	if len(rule.Regions) > 0 || !data.Regions.IsNull() {
+		data.Regions, _ = types.ListValueFrom(ctx, types.StringType, append([]string{}, rule.Regions...))
+	}
+}
+
+func (r *trafficRuleResource) expandMatches(ctx context.Context, data *trafficRuleResourceModel, rule *client.TrafficRule) {
+	if !data.AppIDs.IsNull() && !data.AppIDs.IsUnknown() {
+		var ids []int64
+		data.AppIDs.ElementsAs(ctx, &ids, false)
+		rule.AppIDs = make([]int, len(ids))
+		for i, id := range ids {
+			rule.AppIDs[i] = int(id)
+		}
+	}
+	if !data.AppCategoryIDs.IsNull() && !data.AppCategoryIDs.IsUnknown() {
+		data.AppCategoryIDs.ElementsAs(ctx, &rule.AppCategoryIDs, false)
+	}
+	if !data.Regions.IsNull() && !data.Regions.IsUnknown() {
+		data.Regions.ElementsAs(ctx, &rule.Regions, false)
+	}
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -35,3 +36,29 @@ resource "unifi_traffic_rule" "test" {
 }
 `, getProviderConfig(), action, target, description)
 }
+
+func TestAccTrafficRuleResource_invalidRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTrafficRuleResourceRegionsConfig(`["US", "Germany"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "DE"`),
+			},
+		},
+	})
+}
+
+func testAccTrafficRuleResourceRegionsConfig(regions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_traffic_rule" "test" {
+  action          = "BLOCK"
+  matching_target = "REGION"
+  regions         = %s
+}
+`, getProviderConfig(), regions)
+}
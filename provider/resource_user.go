@@ -2,20 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
+	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/validators"
 )
 
 var _ resource.Resource = &userResource{}
 var _ resource.ResourceWithImportState = &userResource{}
+var _ resource.ResourceWithModifyPlan = &userResource{}
+var _ resource.ResourceWithIdentity = &userResource{}
 
 func NewUserResource() resource.Resource {
 	return &userResource{}
@@ -26,27 +32,29 @@ type userResource struct {
 }
 
 type userResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	MAC         types.String `tfsdk:"mac"`
-	Name        types.String `tfsdk:"name"`
-	Note        types.String `tfsdk:"note"`
-	UseFixedIP  types.Bool   `tfsdk:"use_fixedip"`
-	FixedIP     types.String `tfsdk:"fixed_ip"`
-	NetworkID   types.String `tfsdk:"network_id"`
-	UserGroupID types.String `tfsdk:"user_group_id"`
-	Blocked     types.Bool   `tfsdk:"blocked"`
-	IsWired     types.Bool   `tfsdk:"is_wired"`
-	IsGuest     types.Bool   `tfsdk:"is_guest"`
-	OUI         types.String `tfsdk:"oui"`
-	Noted       types.Bool   `tfsdk:"noted"`
-	SiteID      types.String `tfsdk:"site_id"`
+	ID          types.String          `tfsdk:"id"`
+	MAC         utils.MACAddressValue `tfsdk:"mac"`
+	Name        types.String          `tfsdk:"name"`
+	Note        types.String          `tfsdk:"note"`
+	UseFixedIP  types.Bool            `tfsdk:"use_fixedip"`
+	FixedIP     utils.IPAddressValue  `tfsdk:"fixed_ip"`
+	NetworkID   types.String          `tfsdk:"network_id"`
+	UserGroupID types.String          `tfsdk:"user_group_id"`
+	Blocked     types.Bool            `tfsdk:"blocked"`
+	IsWired     types.Bool            `tfsdk:"is_wired"`
+	IsGuest     types.Bool            `tfsdk:"is_guest"`
+	OUI         types.String          `tfsdk:"oui"`
+	Noted       types.Bool            `tfsdk:"noted"`
+	SiteID      types.String          `tfsdk:"site_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
-func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi user (client device record, DHCP reservation).",
 		Attributes: map[string]schema.Attribute{
@@ -58,11 +66,15 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"mac": schema.StringAttribute{
+				CustomType:          utils.MACAddressType{},
 				Required:            true,
 				MarkdownDescription: "The MAC address of the device.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					validators.MACAddress(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Optional:            true,
@@ -89,12 +101,16 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"fixed_ip": schema.StringAttribute{
+				CustomType:          utils.IPAddressType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The fixed IP address for the device.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
 			},
 			"network_id": schema.StringAttribute{
 				Optional:            true,
@@ -156,6 +172,14 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -166,6 +190,9 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	user := &client.User{
 		MAC:         data.MAC.ValueString(),
 		Name:        data.Name.ValueString(),
@@ -185,6 +212,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,6 +222,9 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	user, err := r.Client.GetUser(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user", err.Error())
@@ -202,6 +233,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.syncState(&data, user)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -211,6 +243,9 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	user := &client.User{
 		ID:          data.ID.ValueString(),
 		MAC:         data.MAC.ValueString(),
@@ -231,6 +266,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,23 +276,57 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteUser(ctx, data.MAC.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting user", err.Error())
 		return
 	}
 }
 
+// ModifyPlan checks that a fixed IP lies within the subnet of its network.
+// The check is skipped when the network cannot be looked up.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.Client == nil {
+		return
+	}
+
+	var fixedIP utils.IPAddressValue
+	var networkID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("fixed_ip"), &fixedIP)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("network_id"), &networkID)...)
+	if resp.Diagnostics.HasError() || fixedIP.IsNull() || fixedIP.IsUnknown() || fixedIP.ValueString() == "" ||
+		networkID.IsNull() || networkID.IsUnknown() || networkID.ValueString() == "" {
+		return
+	}
+
+	networks, err := r.Client.ListNetworks(ctx)
+	if err != nil {
+		return
+	}
+	for _, n := range networks {
+		if n.ID != networkID.ValueString() || n.IPSubnet == "" {
+			continue
+		}
+		if err := validators.CheckIPInSubnet(fixedIP.ValueString(), n.IPSubnet); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fixed_ip"), "Fixed IP Outside Network", fmt.Sprintf("%s (network %q).", err, n.Name))
+		}
+		return
+	}
+}
+
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *userResource) syncState(data *userResourceModel, user *client.User) {
 	data.ID = types.StringValue(user.ID)
-	data.MAC = types.StringValue(user.MAC)
+	data.MAC = utils.NewMACAddressValue(user.MAC)
 	data.Name = utils.StringToValue(user.Name)
 	data.Note = utils.StringToValue(user.Note)
 	data.UseFixedIP = utils.BoolValue(user.UseFixedIP)
-	data.FixedIP = utils.StringToValue(user.FixedIP)
+	data.FixedIP = utils.IPAddressValue{StringValue: utils.StringToValue(user.FixedIP)}
 	data.NetworkID = utils.StringToValue(user.NetworkID)
 	data.UserGroupID = utils.StringToValue(user.UsergroupID)
 	data.Blocked = utils.BoolValue(user.Blocked)
@@ -1,20 +1,22 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
 )
 
 var _ resource.Resource = &userGroupResource{}
 var _ resource.ResourceWithImportState = &userGroupResource{}
+var _ resource.ResourceWithIdentity = &userGroupResource{}
 
 func NewUserGroupResource() resource.Resource {
 	return &userGroupResource{}
@@ -29,13 +31,16 @@ type userGroupResourceModel struct {
 	Name          types.String `tfsdk:"name"`
 	DownloadLimit types.Int64  `tfsdk:"download_limit"`
 	UploadLimit   types.Int64  `tfsdk:"upload_limit"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *userGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user_group"
 }
 
-func (r *userGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi user group (bandwidth profile).",
 		Attributes: map[string]schema.Attribute{
@@ -58,6 +63,15 @@ func (r *userGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "The upload limit in Kbps.",
 			},
+			"adopt_existing": adoptExistingAttribute("user group"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -69,13 +83,28 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	group := &client.UserGroup{
-		Name:           data.Name.ValueString(),
-		QosRateMaxDown: utils.Int64Ptr(data.DownloadLimit),
-		QosRateMaxUp:   utils.Int64Ptr(data.UploadLimit),
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	existing, err := findAdoptable(ctx, data.AdoptExisting, data.Name.ValueString(), r.Client.ListUserGroups, func(g *client.UserGroup) string { return g.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing user groups", err.Error())
+		return
 	}
 
-	created, err := r.Client.CreateUserGroup(ctx, group)
+	group := existing
+	if group == nil {
+		group = &client.UserGroup{Name: data.Name.ValueString()}
+	}
+	group.QosRateMaxDown = utils.Int64Ptr(data.DownloadLimit)
+	group.QosRateMaxUp = utils.Int64Ptr(data.UploadLimit)
+
+	var created *client.UserGroup
+	if existing != nil {
+		created, err = r.Client.UpdateUserGroup(ctx, existing.ID, group)
+	} else {
+		created, err = r.Client.CreateUserGroup(ctx, group)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user group", err.Error())
 		return
@@ -83,6 +112,7 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 
 	r.syncState(&data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -92,6 +122,9 @@ func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	group, err := r.Client.GetUserGroup(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user group", err.Error())
@@ -100,6 +133,7 @@ func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	r.syncState(&data, group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -109,6 +143,9 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	group := &client.UserGroup{
 		ID:             data.ID.ValueString(),
 		Name:           data.Name.ValueString(),
@@ -124,6 +161,7 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 
 	r.syncState(&data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -133,6 +171,14 @@ func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if group, err := r.Client.GetUserGroup(ctx, data.ID.ValueString()); err == nil && isBuiltIn(group.AttrNoDelete, group.AttrHiddenID) {
+		warnBuiltInNotDeleted(&resp.Diagnostics, "user group", group.Name)
+		return
+	}
+
 	if err := r.Client.DeleteUserGroup(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting user group", err.Error())
 		return
@@ -140,7 +186,7 @@ func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *userGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *userGroupResource) syncState(data *userGroupResourceModel, group *client.UserGroup) {
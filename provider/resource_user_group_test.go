@@ -24,6 +24,22 @@ func TestAccUserGroupResource(t *testing.T) {
 	})
 }
 
+func TestAccUserGroupResource_adopt(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupResourceAdoptConfig("Default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_user_group.test", "name", "Default"),
+					resource.TestCheckResourceAttrSet("unifi_user_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccUserGroupResourceConfig(name string, dl, ul int) string {
 	return fmt.Sprintf(`
 %s
@@ -35,3 +51,16 @@ resource "unifi_user_group" "test" {
 }
 `, getProviderConfig(), name, dl, ul)
 }
+
+func testAccUserGroupResourceAdoptConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "unifi_user_group" "test" {
+  name           = %[2]q
+  download_limit = -1
+  upload_limit   = -1
+  adopt_existing = true
+}
+`, getProviderConfig(), name)
+}
@@ -1,21 +1,28 @@
 package provider
 
 import (
-	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 	"github.com/jlopez/terraform-provider-unifi-network/internal/provider/utils"
 )
 
 var _ resource.Resource = &wlanResource{}
 var _ resource.ResourceWithImportState = &wlanResource{}
+var _ resource.ResourceWithIdentity = &wlanResource{}
 
 func NewWLANResource() resource.Resource {
 	return &wlanResource{}
@@ -26,21 +33,26 @@ type wlanResource struct {
 }
 
 type wlanResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Passphrase  types.String `tfsdk:"passphrase"`
-	Security    types.String `tfsdk:"security"`
-	NetworkID   types.String `tfsdk:"network_id"`
-	APGroupIDs  types.List   `tfsdk:"ap_group_ids"`
-	UserGroupID types.String `tfsdk:"user_group_id"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Passphrase          types.String `tfsdk:"passphrase"`
+	PassphraseWO        types.String `tfsdk:"passphrase_wo"`
+	PassphraseWOVersion types.Int64  `tfsdk:"passphrase_wo_version"`
+	Security            types.String `tfsdk:"security"`
+	NetworkID           types.String `tfsdk:"network_id"`
+	APGroupIDs          types.List   `tfsdk:"ap_group_ids"`
+	UserGroupID         types.String `tfsdk:"user_group_id"`
+
+	WaitForProvision types.Bool     `tfsdk:"wait_for_provision"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *wlanResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wlan"
 }
 
-func (r *wlanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *wlanResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a UniFi wireless network (SSID).",
 		Attributes: map[string]schema.Attribute{
@@ -66,7 +78,23 @@ func (r *wlanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"passphrase": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The passphrase for the wireless network.",
+				MarkdownDescription: "The passphrase for the wireless network. It is stored in state; prefer `passphrase_wo`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("passphrase_wo")),
+				},
+			},
+			"passphrase_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "The passphrase for the wireless network, which is never stored in state. Requires Terraform 1.11 or later. Changes are only applied when `passphrase_wo_version` changes.",
+			},
+			"passphrase_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to apply a new `passphrase_wo`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("passphrase_wo")),
+				},
 			},
 			"security": schema.StringAttribute{
 				Optional:            true,
@@ -98,6 +126,15 @@ func (r *wlanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_provision": waitForProvisionAttribute("the access points"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -109,6 +146,9 @@ func (r *wlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var apGroupIDs []string
 	if !data.APGroupIDs.IsNull() && !data.APGroupIDs.IsUnknown() {
 		resp.Diagnostics.Append(data.APGroupIDs.ElementsAs(ctx, &apGroupIDs, false)...)
@@ -120,7 +160,7 @@ func (r *wlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 	wlan := &client.WLANConf{
 		Name:          data.Name.ValueString(),
 		Enabled:       utils.BoolPtr(data.Enabled),
-		XPassphrase:   data.Passphrase.ValueString(),
+		XPassphrase:   r.passphrase(ctx, &data, req.Config, &resp.Diagnostics),
 		Security:      utils.StringOrEmpty(data.Security),
 		NetworkConfID: data.NetworkID.ValueString(),
 		APGroupIDs:    apGroupIDs,
@@ -139,6 +179,12 @@ func (r *wlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	r.syncState(ctx, &data, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "uap", "udm")
 }
 
 func (r *wlanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -148,6 +194,9 @@ func (r *wlanResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	wlan, err := r.Client.GetWLAN(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading WLAN", err.Error())
@@ -156,6 +205,7 @@ func (r *wlanResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.syncState(ctx, &data, wlan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -165,6 +215,9 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var apGroupIDs []string
 	if !data.APGroupIDs.IsNull() && !data.APGroupIDs.IsUnknown() {
 		resp.Diagnostics.Append(data.APGroupIDs.ElementsAs(ctx, &apGroupIDs, false)...)
@@ -177,7 +230,7 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		ID:            data.ID.ValueString(),
 		Name:          data.Name.ValueString(),
 		Enabled:       utils.BoolPtr(data.Enabled),
-		XPassphrase:   data.Passphrase.ValueString(),
+		XPassphrase:   r.passphrase(ctx, &data, req.Config, &resp.Diagnostics),
 		Security:      data.Security.ValueString(),
 		NetworkConfID: data.NetworkID.ValueString(),
 		APGroupIDs:    apGroupIDs,
@@ -192,6 +245,12 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	r.syncState(ctx, &data, updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIdentityModel{ID: data.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "uap", "udm")
 }
 
 func (r *wlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -201,14 +260,31 @@ func (r *wlanResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.Client.DeleteWLAN(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting WLAN", err.Error())
 		return
 	}
+
+	r.waitForProvision(ctx, data.WaitForProvision, &resp.Diagnostics, "uap", "udm")
 }
 
 func (r *wlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// passphrase returns the passphrase to send, reading passphrase_wo from the
+// configuration because write-only values never appear in the plan.
+func (r *wlanResource) passphrase(ctx context.Context, data *wlanResourceModel, config tfsdk.Config, diags *diag.Diagnostics) string {
+	if !data.Passphrase.IsNull() {
+		return data.Passphrase.ValueString()
+	}
+
+	var passphraseWO types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("passphrase_wo"), &passphraseWO)...)
+	return passphraseWO.ValueString()
 }
 
 func (r *wlanResource) syncState(ctx context.Context, data *wlanResourceModel, wlan *client.WLANConf) {
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccWLANResource(t *testing.T) {
@@ -23,6 +27,32 @@ func TestAccWLANResource(t *testing.T) {
 	})
 }
 
+func TestAccWLANResource_writeOnlyPassphrase(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANResourceWriteOnlyConfig("TestSSIDWO", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("unifi_wlan.test", tfjsonpath.New("passphrase"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("unifi_wlan.test", tfjsonpath.New("passphrase_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("unifi_wlan.test", tfjsonpath.New("passphrase_wo_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: testAccWLANResourceWriteOnlyConfig("TestSSIDWO", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("unifi_wlan.test", tfjsonpath.New("passphrase_wo_version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
 func testAccWLANResourceConfig(name, passphrase string) string {
 	return fmt.Sprintf(`
 %s
@@ -40,3 +70,18 @@ resource "unifi_wlan" "test" {
 }
 `, getProviderConfig(), name, passphrase)
 }
+
+func testAccWLANResourceWriteOnlyConfig(name string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "unifi_wlan_passphrase" "test" {}
+
+resource "unifi_wlan" "test" {
+  name                  = %[2]q
+  passphrase_wo         = ephemeral.unifi_wlan_passphrase.test.passphrase
+  passphrase_wo_version = %[3]d
+  security              = "wpapsk"
+}
+`, getProviderConfig(), name, version)
+}
@@ -1,8 +1,22 @@
 package provider
 
 import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	client "github.com/jlopez/terraform-provider-unifi-network/internal/client"
 )
 
 const (
@@ -36,3 +50,76 @@ var (
 		"unifi": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// runFunction calls a provider function directly with args and returns its
+// result, so functions can be unit tested without Terraform.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+	result, funcErr := def.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("creating result data: %s", funcErr)
+	}
+
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// runAction checks config against the validators in the action's schema and,
+// when it passes, invokes the action with c as its client, so actions can be
+// unit tested without Terraform. Attributes missing from config are null.
+func runAction(t *testing.T, a action.Action, c *client.Client, config map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+		if v, ok := config[name]; ok {
+			values[name] = v
+		}
+	}
+	cfg := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+
+	var diags diag.Diagnostics
+	for name, attribute := range schemaResp.Schema.Attributes {
+		p := path.Root(name)
+		switch attribute := attribute.(type) {
+		case schema.StringAttribute:
+			var v types.String
+			diags.Append(cfg.GetAttribute(ctx, p, &v)...)
+			for _, val := range attribute.Validators {
+				resp := validator.StringResponse{}
+				val.ValidateString(ctx, validator.StringRequest{Path: p, Config: cfg, ConfigValue: v}, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.Int64Attribute:
+			var v types.Int64
+			diags.Append(cfg.GetAttribute(ctx, p, &v)...)
+			for _, val := range attribute.Validators {
+				resp := validator.Int64Response{}
+				val.ValidateInt64(ctx, validator.Int64Request{Path: p, Config: cfg, ConfigValue: v}, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if configurable, ok := a.(action.ActionWithConfigure); ok {
+		var configureResp action.ConfigureResponse
+		configurable.Configure(ctx, action.ConfigureRequest{ProviderData: c}, &configureResp)
+		diags.Append(configureResp.Diagnostics...)
+	}
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{Config: cfg}, &resp)
+	return append(diags, resp.Diagnostics...)
+}